@@ -0,0 +1,4 @@
+package models
+
+// models.json is written by tools/specfilter from the models section of api/v1/services.yaml
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen -config cfg.yaml models.json
@@ -0,0 +1,150 @@
+// Package models provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package models
+
+// "github.com/hashicorp/go-retryablehttp"
+
+// Availability defines model for Availability.
+type Availability struct {
+	Available *bool `json:"available,omitempty"`
+
+	// AvailableNodeNames List of node names where this configuration is available. Omitted for on-demand resource pools
+	AvailableNodeNames *[]string `json:"availableNodeNames"`
+	Cluster            *string   `json:"cluster"`
+	Configuration      *string   `json:"configuration"`
+
+	// Count number of servers that can be created with this configuration.
+	Count *int32 `json:"count,omitempty"`
+
+	// MaxCount maximum number of servers that can be created with this configuration. 0 if resource pool is on-demand.
+	MaxCount *int32   `json:"maxCount,omitempty"`
+	Price    *float64 `json:"price,omitempty"`
+	Rpool    *string  `json:"rpool"`
+	// Deprecated:
+	Type *string `json:"type"`
+}
+
+// GetAvailable returns the Available field value if set, zero value otherwise.
+func (v *Availability) GetAvailable() bool {
+	if v == nil || v.Available == nil {
+		var zero bool
+		return zero
+	}
+	return *v.Available
+}
+
+// GetAvailableNodeNames returns the AvailableNodeNames field value if set, zero value otherwise.
+func (v *Availability) GetAvailableNodeNames() []string {
+	if v == nil || v.AvailableNodeNames == nil {
+		var zero []string
+		return zero
+	}
+	return *v.AvailableNodeNames
+}
+
+// GetCluster returns the Cluster field value if set, zero value otherwise.
+func (v *Availability) GetCluster() string {
+	if v == nil || v.Cluster == nil {
+		var zero string
+		return zero
+	}
+	return *v.Cluster
+}
+
+// GetConfiguration returns the Configuration field value if set, zero value otherwise.
+func (v *Availability) GetConfiguration() string {
+	if v == nil || v.Configuration == nil {
+		var zero string
+		return zero
+	}
+	return *v.Configuration
+}
+
+// GetCount returns the Count field value if set, zero value otherwise.
+func (v *Availability) GetCount() int32 {
+	if v == nil || v.Count == nil {
+		var zero int32
+		return zero
+	}
+	return *v.Count
+}
+
+// GetMaxCount returns the MaxCount field value if set, zero value otherwise.
+func (v *Availability) GetMaxCount() int32 {
+	if v == nil || v.MaxCount == nil {
+		var zero int32
+		return zero
+	}
+	return *v.MaxCount
+}
+
+// GetPrice returns the Price field value if set, zero value otherwise.
+func (v *Availability) GetPrice() float64 {
+	if v == nil || v.Price == nil {
+		var zero float64
+		return zero
+	}
+	return *v.Price
+}
+
+// GetRpool returns the Rpool field value if set, zero value otherwise.
+func (v *Availability) GetRpool() string {
+	if v == nil || v.Rpool == nil {
+		var zero string
+		return zero
+	}
+	return *v.Rpool
+}
+
+// GetType returns the Type field value if set, zero value otherwise.
+//
+// Deprecated: Type is deprecated in the spec.
+func (v *Availability) GetType() string {
+	if v == nil || v.Type == nil {
+		var zero string
+		return zero
+	}
+	return *v.Type
+}
+
+// AvailabilityParams defines model for AvailabilityParams.
+type AvailabilityParams struct {
+	Cluster string `json:"cluster"`
+
+	// ReportNodes controls if Count and MaxCount is calculated and returned in the response. If they are not needed, use 'false' to improve response time of the endpoint.
+	ReportNodes  *bool   `json:"reportNodes,omitempty"`
+	ResourcePool *string `json:"resourcePool,omitempty"`
+}
+
+// GetCluster returns the Cluster field value if set, zero value otherwise.
+func (v *AvailabilityParams) GetCluster() string {
+	if v == nil {
+		var zero string
+		return zero
+	}
+	return v.Cluster
+}
+
+// GetReportNodes returns the ReportNodes field value if set, zero value otherwise.
+func (v *AvailabilityParams) GetReportNodes() bool {
+	if v == nil || v.ReportNodes == nil {
+		var zero bool
+		return zero
+	}
+	return *v.ReportNodes
+}
+
+// GetResourcePool returns the ResourcePool field value if set, zero value otherwise.
+func (v *AvailabilityParams) GetResourcePool() string {
+	if v == nil || v.ResourcePool == nil {
+		var zero string
+		return zero
+	}
+	return *v.ResourcePool
+}
+
+// Ptr returns a pointer to v, for setting the optional fields of the models (e.g., Ptr("my-vm")).
+func Ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,27 @@
+// Package models defines the types shared by the v1 services.
+//
+// The generated packages alias these types rather than defining their own copies (see the models section of
+// api/v1/services.yaml), so availability and list results can be handled generically across services.
+// The shared schemas are generated from models.json, which tools/specfilter merges from the services' schemas:
+//
+//	var rsp *models.ListResult[models.Availability]
+//	params := &models.AvailabilityParams{Cluster: "Msc1", ResourcePool: models.Ptr("on-demand")}
+//	rsp, err = vc.GetAvailability(ctx, params)
+//	rsp, err = ac.GetAvailability(ctx, params)
+//
+// Errors are already shared by every service as a *response.StatusError.
+package models
+
+// ListResult is a list of items, which every ListResultDtoOf<Schema> aliases.
+// It's generic so it can't be generated, but tools/specfilter only aliases schemas with this exact shape.
+type ListResult[T any] struct {
+	Items *[]T `json:"items"`
+}
+
+// GetItems returns the items, which is nil if the list or its items are nil.
+func (l *ListResult[T]) GetItems() []T {
+	if l == nil || l.Items == nil {
+		return nil
+	}
+	return *l.Items
+}
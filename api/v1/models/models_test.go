@@ -0,0 +1,42 @@
+package models_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/denvrdata/go-denvr/api/v1/models"
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShared(t *testing.T) {
+	availability := reflect.TypeFor[models.ListResult[models.Availability]]()
+	assert.Equal(t, availability, reflect.TypeFor[virtual.ListResultDtoOfServerAvailability]())
+	assert.Equal(t, availability, reflect.TypeFor[applications.ListResultDtoOfApplicationsApiApplicationConfigAvailability]())
+
+	params := reflect.TypeFor[models.AvailabilityParams]()
+	assert.Equal(t, params, reflect.TypeFor[virtual.GetAvailabilityParams]())
+	assert.Equal(t, params, reflect.TypeFor[applications.GetAvailabilityParams]())
+}
+
+func TestListResult(t *testing.T) {
+	var rsp *models.ListResult[models.Availability]
+	assert.Nil(t, rsp.GetItems())
+
+	require.NoError(
+		t,
+		json.Unmarshal([]byte(`{"items": [{"cluster": "Msc1", "configuration": "A100_40GB_PCIe_1x", "available": true, "count": 2}]}`), &rsp),
+	)
+	values := rsp.GetItems()
+	require.Len(t, values, 1)
+	assert.Equal(t, "Msc1", values[0].GetCluster())
+	assert.Equal(t, int32(2), values[0].GetCount())
+	assert.Nil(t, values[0].Price)
+	assert.Zero(t, values[0].GetPrice())
+
+	rsp = &models.ListResult[models.Availability]{}
+	assert.Nil(t, rsp.GetItems())
+}
@@ -0,0 +1,253 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+)
+
+// Quantity is an amount of memory or storage in bytes.
+//
+// The API reports sizes in different units (e.g., memory in GB, root disks as a number or string of Gi),
+// so fields are generated as Gigabytes, Gibibytes or GibibytesString depending on their encoding,
+// which all share the same Quantity value:
+//
+//	details.GetRootDiskSize().In(models.GiB)
+//	virtual.CreateVirtualServerInput{RootDiskSize: virtual.Ptr(models.NewGibibytes(100 * models.GiB))}
+type Quantity int64
+
+// Common quantities, which can be multiplied (e.g., 100 * models.GiB).
+const (
+	Byte Quantity = 1
+
+	KB Quantity = 1000 * Byte
+	MB Quantity = 1000 * KB
+	GB Quantity = 1000 * MB
+	TB Quantity = 1000 * GB
+	PB Quantity = 1000 * TB
+	EB Quantity = 1000 * PB
+
+	KiB Quantity = 1024 * Byte
+	MiB Quantity = 1024 * KiB
+	GiB Quantity = 1024 * MiB
+	TiB Quantity = 1024 * GiB
+	PiB Quantity = 1024 * TiB
+	EiB Quantity = 1024 * PiB
+)
+
+// suffixes maps the Kubernetes suffixes and the usual unit names to their quantity.
+var suffixes = map[string]Quantity{
+	"": Byte, "B": Byte,
+	"k": KB, "K": KB, "KB": KB, "kB": KB, "M": MB, "MB": MB, "G": GB, "GB": GB,
+	"T": TB, "TB": TB, "P": PB, "PB": PB, "E": EB, "EB": EB,
+	"Ki": KiB, "KiB": KiB, "Mi": MiB, "MiB": MiB, "Gi": GiB, "GiB": GiB,
+	"Ti": TiB, "TiB": TiB, "Pi": PiB, "PiB": PiB, "Ei": EiB, "EiB": EiB,
+}
+
+// formats are the suffixes used by String.
+var formats = []struct {
+	unit   Quantity
+	suffix string
+}{
+	{EiB, "Ei"}, {PiB, "Pi"}, {TiB, "Ti"}, {GiB, "Gi"}, {MiB, "Mi"}, {KiB, "Ki"},
+	{EB, "E"}, {PB, "P"}, {TB, "T"}, {GB, "G"}, {MB, "M"}, {KB, "k"},
+}
+
+var quantityPattern = regexp.MustCompile(`^\s*(\+?[0-9]*\.?[0-9]+(?:[eE][+-]?[0-9]+)?)\s*([A-Za-z]*)\s*$`)
+
+// ParseQuantity parses Kubernetes quantities (e.g., 100Gi, 1.5T, 1e9) and sizes with units (e.g., 100GB, 16 GiB).
+// Numbers without a unit are bytes, and fractional bytes are rounded up.
+func ParseQuantity(s string) (Quantity, error) {
+	return parseQuantity(s, Byte)
+}
+
+// parseQuantity is ParseQuantity with numbers without a unit in bare units.
+func parseQuantity(s string, bare Quantity) (Quantity, error) {
+	match := quantityPattern.FindStringSubmatch(s)
+	if match == nil {
+		return 0, fmt.Errorf("invalid quantity %q", s)
+	}
+	unit, ok := suffixes[match[2]]
+	if match[2] == "" {
+		unit = bare
+	}
+	if !ok {
+		return 0, fmt.Errorf("invalid quantity %q: unknown unit %q", s, match[2])
+	}
+	value, ok := new(big.Rat).SetString(match[1])
+	if !ok {
+		return 0, fmt.Errorf("invalid quantity %q", s)
+	}
+	return fromRat(value, unit)
+}
+
+// fromRat converts value units to a whole number of bytes, rounding up.
+func fromRat(value *big.Rat, unit Quantity) (Quantity, error) {
+	value = new(big.Rat).Mul(value, new(big.Rat).SetInt64(int64(unit)))
+	if value.Sign() < 0 {
+		return 0, fmt.Errorf("negative quantity %s", value.FloatString(0))
+	}
+	n, rem := new(big.Int).QuoRem(value.Num(), value.Denom(), new(big.Int))
+	if rem.Sign() != 0 {
+		n.Add(n, big.NewInt(1))
+	}
+	if !n.IsInt64() {
+		return 0, fmt.Errorf("quantity %s bytes is too large", n)
+	}
+	return Quantity(n.Int64()), nil
+}
+
+// String formats the quantity as a Kubernetes quantity, with the largest unit which is exact (e.g., 100Gi or 970G).
+func (q Quantity) String() string {
+	if q == 0 {
+		return "0"
+	}
+	unit, suffix := Byte, ""
+	for _, f := range formats {
+		if q%f.unit == 0 && f.unit > unit {
+			unit, suffix = f.unit, f.suffix
+		}
+	}
+	return strconv.FormatInt(int64(q/unit), 10) + suffix
+}
+
+// Bytes returns the quantity as a number of bytes.
+func (q Quantity) Bytes() int64 {
+	return int64(q)
+}
+
+// In returns the quantity as a number of units (e.g., q.In(models.GB)).
+func (q Quantity) In(unit Quantity) float64 {
+	return float64(q) / float64(unit)
+}
+
+// MarshalJSON encodes the quantity as a Kubernetes quantity string.
+func (q Quantity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.String())
+}
+
+// UnmarshalJSON parses a quantity string with ParseQuantity, or a number of bytes.
+// Empty strings are decoded as zero.
+func (q *Quantity) UnmarshalJSON(data []byte) error {
+	return unmarshalString(data, q, Byte)
+}
+
+// Gigabytes is a Quantity encoded as a number of GB (e.g., VirtualServerDetailsItem.Memory).
+type Gigabytes struct {
+	Quantity
+}
+
+// NewGigabytes returns q as Gigabytes.
+func NewGigabytes(q Quantity) Gigabytes {
+	return Gigabytes{q}
+}
+
+// MarshalJSON encodes the quantity as a whole number of GB.
+func (g Gigabytes) MarshalJSON() ([]byte, error) {
+	return marshalIn(g.Quantity, GB, "GB")
+}
+
+// UnmarshalJSON decodes a number of GB.
+func (g *Gigabytes) UnmarshalJSON(data []byte) error {
+	return unmarshalIn(data, &g.Quantity, GB)
+}
+
+// Gibibytes is a Quantity encoded as a number of Gi (e.g., CreateVirtualServerInput.RootDiskSize).
+type Gibibytes struct {
+	Quantity
+}
+
+// NewGibibytes returns q as Gibibytes.
+func NewGibibytes(q Quantity) Gibibytes {
+	return Gibibytes{q}
+}
+
+// MarshalJSON encodes the quantity as a whole number of Gi.
+func (g Gibibytes) MarshalJSON() ([]byte, error) {
+	return marshalIn(g.Quantity, GiB, "Gi")
+}
+
+// UnmarshalJSON decodes a number of Gi.
+func (g *Gibibytes) UnmarshalJSON(data []byte) error {
+	return unmarshalIn(data, &g.Quantity, GiB)
+}
+
+// GibibytesString is a Quantity encoded as a string, where numbers without a unit are Gi
+// (e.g., VirtualServerDetailsItem.RootDiskSize is "100" for a 100Gi disk, like CreateVirtualServerInput.RootDiskSize).
+type GibibytesString struct {
+	Quantity
+}
+
+// NewGibibytesString returns q as GibibytesString.
+func NewGibibytesString(q Quantity) GibibytesString {
+	return GibibytesString{q}
+}
+
+// MarshalJSON encodes the quantity as a number of Gi, or as a Kubernetes quantity if it isn't a whole number of Gi.
+func (g GibibytesString) MarshalJSON() ([]byte, error) {
+	if g.Quantity%GiB != 0 {
+		return g.Quantity.MarshalJSON()
+	}
+	return json.Marshal(strconv.FormatInt(int64(g.Quantity/GiB), 10))
+}
+
+// UnmarshalJSON parses a quantity string with ParseQuantity, where numbers without a unit are Gi.
+func (g *GibibytesString) UnmarshalJSON(data []byte) error {
+	return unmarshalString(data, &g.Quantity, GiB)
+}
+
+// unmarshalString decodes a quantity string, or a number, where numbers without a unit are bare units.
+func unmarshalString(data []byte, q *Quantity, bare Quantity) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	if len(data) > 0 && data[0] != '"' {
+		return unmarshalIn(data, q, bare)
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*q = 0
+		return nil
+	}
+	parsed, err := parseQuantity(s, bare)
+	if err != nil {
+		return err
+	}
+	*q = parsed
+	return nil
+}
+
+// marshalIn encodes q as a whole number of units, as the API doesn't accept fractions.
+func marshalIn(q Quantity, unit Quantity, name string) ([]byte, error) {
+	if q%unit != 0 {
+		return nil, fmt.Errorf("%s isn't a whole number of %s", q, name)
+	}
+	return []byte(strconv.FormatInt(int64(q/unit), 10)), nil
+}
+
+// unmarshalIn decodes a number of units.
+func unmarshalIn(data []byte, q *Quantity, unit Quantity) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	value, ok := new(big.Rat).SetString(n.String())
+	if !ok {
+		return fmt.Errorf("invalid quantity %s", data)
+	}
+	parsed, err := fromRat(value, unit)
+	if err != nil {
+		return err
+	}
+	*q = parsed
+	return nil
+}
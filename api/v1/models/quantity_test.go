@@ -0,0 +1,121 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/denvrdata/go-denvr/api/v1/models"
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuantity(t *testing.T) {
+	for s, expected := range map[string]models.Quantity{
+		"0":          0,
+		"1024":       models.KiB,
+		"100Gi":      100 * models.GiB,
+		"100 GiB":    100 * models.GiB,
+		"1.5Ti":      1536 * models.GiB,
+		"16G":        16 * models.GB,
+		"16GB":       16 * models.GB,
+		"500k":       500 * models.KB,
+		"1e9":        models.GB,
+		"2.5e3M":     2500 * models.MB,
+		"0.5":        1,
+		" 970 GB ":   970 * models.GB,
+		"+2Mi":       2 * models.MiB,
+		"1.0000001k": 1001,
+	} {
+		actual, err := models.ParseQuantity(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, expected, actual, s)
+	}
+
+	_, err := models.ParseQuantity("")
+	assert.EqualError(t, err, `invalid quantity ""`)
+	_, err = models.ParseQuantity("100 apples")
+	assert.EqualError(t, err, `invalid quantity "100 apples": unknown unit "apples"`)
+	_, err = models.ParseQuantity("-1Gi")
+	assert.Error(t, err)
+	_, err = models.ParseQuantity("100Ei")
+	assert.EqualError(t, err, "quantity 115292150460684697600 bytes is too large")
+}
+
+func TestQuantityString(t *testing.T) {
+	assert.Equal(t, "0", models.Quantity(0).String())
+	assert.Equal(t, "100Gi", (100 * models.GiB).String())
+	assert.Equal(t, "1536Mi", (1536 * models.MiB).String())
+	assert.Equal(t, "970G", (970 * models.GB).String())
+	assert.Equal(t, "1001", models.Quantity(1001).String())
+
+	assert.Equal(t, int64(1<<30), models.GiB.Bytes())
+	assert.Equal(t, 1.5, (1536 * models.MiB).In(models.GiB))
+	assert.Equal(t, float64(16), (16 * models.GB).In(models.GB))
+}
+
+func TestQuantityJSON(t *testing.T) {
+	var q models.Quantity
+	require.NoError(t, json.Unmarshal([]byte(`"100Gi"`), &q))
+	assert.Equal(t, 100*models.GiB, q)
+	require.NoError(t, json.Unmarshal([]byte(`1073741824`), &q))
+	assert.Equal(t, models.GiB, q)
+	require.NoError(t, json.Unmarshal([]byte(`""`), &q))
+	assert.Zero(t, q)
+	assert.Error(t, json.Unmarshal([]byte(`"lots"`), &q))
+	assert.Error(t, json.Unmarshal([]byte(`true`), &q))
+
+	data, err := json.Marshal(100 * models.GiB)
+	require.NoError(t, err)
+	assert.Equal(t, `"100Gi"`, string(data))
+
+	var server virtual.VirtualServerDetailsItem
+	require.NoError(
+		t,
+		json.Unmarshal([]byte(`{"memory": 125, "storage": 1700, "rootDiskSize": "100"}`), &server),
+	)
+	assert.Equal(t, 125*models.GB, server.GetMemory().Quantity)
+	assert.Equal(t, 1700*models.GB, server.GetStorage().Quantity)
+	assert.Equal(t, 100*models.GiB, server.GetRootDiskSize().Quantity, "root disk sizes without a unit are Gi")
+	data, err = json.Marshal(server)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"rootDiskSize":"100"`)
+
+	var disk models.GibibytesString
+	for s, expected := range map[string]models.Quantity{
+		`"100"`:   100 * models.GiB,
+		`"1.5"`:   1536 * models.MiB,
+		`"100Gi"`: 100 * models.GiB,
+		`"500G"`:  500 * models.GB,
+		`100`:     100 * models.GiB,
+		`""`:      0,
+	} {
+		require.NoError(t, json.Unmarshal([]byte(s), &disk), s)
+		assert.Equal(t, expected, disk.Quantity, s)
+	}
+	data, err = json.Marshal(models.NewGibibytesString(500 * models.GB))
+	require.NoError(t, err)
+	assert.Equal(t, `"500G"`, string(data))
+
+	var config applications.ApplicationsApiApplicationConfig
+	require.NoError(t, json.Unmarshal([]byte(`{"memoryGb": 970, "directAttachedStorageGb": 17000}`), &config))
+	assert.Equal(t, "970G", config.GetMemoryGb().String())
+	assert.Equal(t, 17*models.TB, config.GetDirectAttachedStorageGb().Quantity)
+
+	input := virtual.CreateVirtualServerInput{RootDiskSize: virtual.Ptr(models.NewGibibytes(100 * models.GiB))}
+	data, err = json.Marshal(input)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"rootDiskSize":100`)
+
+	// The API only accepts whole numbers of units
+	_, err = json.Marshal(models.NewGibibytes(1500 * models.MiB))
+	assert.ErrorContains(t, err, "1500Mi isn't a whole number of Gi")
+	_, err = json.Marshal(models.NewGigabytes(models.GiB))
+	assert.ErrorContains(t, err, "1Gi isn't a whole number of GB")
+
+	var gi models.Gibibytes
+	require.NoError(t, json.Unmarshal([]byte(`1.5`), &gi))
+	assert.Equal(t, 1536*models.MiB, gi.Quantity)
+	assert.Error(t, json.Unmarshal([]byte(`"100Gi"`), &gi))
+}
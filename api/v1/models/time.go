@@ -0,0 +1,81 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// timeLayouts are the formats we've seen for the API timestamps (e.g., LastUpdated and CreationTime).
+// Fractional seconds are optional in all of them.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+}
+
+// Time is an API timestamp. The API doesn't always include a time zone, so timestamps are parsed leniently
+// (see ParseTime) rather than with the strict RFC3339 parsing of time.Time.
+//
+// Timestamps which can't be parsed don't fail the whole response: they're decoded as the zero time,
+// with the parsing error available from Err.
+type Time struct {
+	time.Time
+
+	raw string
+	err error
+}
+
+// ParseTime parses an API timestamp, with or without a time zone (defaulting to UTC) and fractional seconds.
+func ParseTime(s string) (Time, error) {
+	if s == "" {
+		return Time{}, errors.New("missing timestamp")
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return Time{Time: t}, nil
+		}
+	}
+	return Time{}, fmt.Errorf("unsupported timestamp %q", s)
+}
+
+// Err returns the error from decoding a timestamp which couldn't be parsed, or nil.
+func (t Time) Err() error {
+	return t.err
+}
+
+// MarshalJSON encodes the time as RFC3339 with fractional seconds, which ParseTime accepts.
+// Timestamps which couldn't be parsed are encoded as they were received.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.err != nil {
+		return []byte(t.raw), nil
+	}
+	return json.Marshal(t.Format(time.RFC3339Nano))
+}
+
+// UnmarshalJSON parses the timestamp with ParseTime. Empty strings are decoded as the zero time,
+// and invalid timestamps as the zero time with an Err.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	*t = Time{}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		t.raw, t.err = string(data), fmt.Errorf("unsupported timestamp %s", data)
+		return nil
+	}
+	if s == "" {
+		return nil
+	}
+	parsed, err := ParseTime(s)
+	if err != nil {
+		t.raw, t.err = string(data), err
+		return nil
+	}
+	*t = parsed
+	return nil
+}
@@ -0,0 +1,97 @@
+package models_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/denvrdata/go-denvr/api/v1/models"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTime(t *testing.T) {
+	expected := time.Date(2024, 11, 5, 13, 30, 0, 0, time.UTC)
+	for _, s := range []string{
+		"2024-11-05T13:30:00Z",
+		"2024-11-05T13:30:00.000000Z",
+		"2024-11-05T08:30:00-05:00",
+		"2024-11-05T08:30:00.5-05:00",
+		"2024-11-05T13:30:00",
+		"2024-11-05T13:30:00.123",
+		"2024-11-05 13:30:00",
+	} {
+		actual, err := models.ParseTime(s)
+		assert.NoError(t, err, s)
+		assert.True(t, expected.Equal(actual.Truncate(time.Second)), s)
+	}
+
+	naive, err := models.ParseTime("2024-11-05T13:30:00")
+	require.NoError(t, err)
+	assert.Equal(t, time.UTC, naive.Location())
+
+	_, err = models.ParseTime("")
+	assert.EqualError(t, err, "missing timestamp")
+	_, err = models.ParseTime("yesterday")
+	assert.EqualError(t, err, `unsupported timestamp "yesterday"`)
+}
+
+func TestTimeJSON(t *testing.T) {
+	var servers []virtual.VirtualServerDetailsItem
+	require.NoError(
+		t,
+		json.Unmarshal(
+			[]byte(`[
+				{"id": "new", "lastUpdated": "2024-11-05T13:30:00.123456"},
+				{"id": "old", "lastUpdated": "2024-11-01T08:00:00-05:00"},
+				{"id": "unknown", "lastUpdated": null},
+				{"id": "empty", "lastUpdated": ""}
+			]`),
+			&servers,
+		),
+	)
+	assert.Equal(t, time.Date(2024, 11, 5, 13, 30, 0, 123456000, time.UTC), servers[0].GetLastUpdated().Time)
+	assert.Nil(t, servers[2].LastUpdated)
+	assert.True(t, servers[3].GetLastUpdated().IsZero())
+
+	// Timestamps can be compared for age-based sorting
+	slices.SortFunc(servers[:2], func(a, b virtual.VirtualServerDetailsItem) int {
+		return a.GetLastUpdated().Compare(b.GetLastUpdated().Time)
+	})
+	assert.Equal(t, "old", servers[0].GetId())
+
+	// Round trip
+	data, err := json.Marshal(servers[1])
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"lastUpdated":"2024-11-05T13:30:00.123456Z"`)
+	var decoded virtual.VirtualServerDetailsItem
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.True(t, servers[1].GetLastUpdated().Equal(decoded.GetLastUpdated().Time))
+
+	// Invalid timestamps don't fail the whole response, they're zero with an error
+	var invalid models.Time
+	require.NoError(t, json.Unmarshal([]byte(`"yesterday"`), &invalid))
+	assert.True(t, invalid.IsZero())
+	assert.EqualError(t, invalid.Err(), `unsupported timestamp "yesterday"`)
+	data, err = json.Marshal(invalid)
+	require.NoError(t, err)
+	assert.Equal(t, `"yesterday"`, string(data))
+	require.NoError(t, json.Unmarshal([]byte(`1730813400`), &invalid))
+	assert.Error(t, invalid.Err())
+
+	var rsp virtual.ListResultDtoOfVirtualServerDetailsItem
+	require.NoError(
+		t,
+		json.Unmarshal(
+			[]byte(`{"items": [{"id": "offset", "lastUpdated": "2024-11-05T13:30:00+0000"}, {"id": "valid", "lastUpdated": "2024-11-05T13:30:00Z"}]}`),
+			&rsp,
+		),
+	)
+	items := rsp.GetItems()
+	require.Len(t, items, 2)
+	assert.Error(t, items[0].GetLastUpdated().Err())
+	assert.NoError(t, items[1].GetLastUpdated().Err())
+	assert.False(t, items[1].GetLastUpdated().IsZero())
+}
@@ -0,0 +1,3 @@
+package registry
+
+//go:generate go run github.com/denvrdata/go-denvr/tools/opsgen -spec ../api.json -output registry.gen.go
@@ -0,0 +1,232 @@
+// Code generated by github.com/denvrdata/go-denvr/tools/opsgen DO NOT EDIT.
+
+package registry
+
+import (
+	"reflect"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+)
+
+// Operations lists every operation in the embedded spec, sorted by tag, path and method.
+var Operations = []Operation{
+	{
+		ID:       "CreateCatalogApplication",
+		Method:   "POST",
+		Path:     "/api/v1/servers/applications/CreateCatalogApplication",
+		Tag:      "servers/applications",
+		Package:  "applications",
+		Request:  reflect.TypeFor[applications.CreateCatalogApplicationJSONRequestBody](),
+		Response: reflect.TypeFor[applications.ApplicationsApiOverview](),
+	},
+	{
+		ID:       "CreateCustomApplication",
+		Method:   "POST",
+		Path:     "/api/v1/servers/applications/CreateCustomApplication",
+		Tag:      "servers/applications",
+		Package:  "applications",
+		Request:  reflect.TypeFor[applications.CreateCustomApplicationJSONRequestBody](),
+		Response: reflect.TypeFor[applications.ApplicationsApiOverview](),
+	},
+	{
+		ID:      "DestroyApplication",
+		Method:  "DELETE",
+		Path:    "/api/v1/servers/applications/DestroyApplication",
+		Tag:     "servers/applications",
+		Package: "applications",
+		Params: []Param{
+			{Name: "Id", In: "query", Required: true, Type: "string", Format: "", Description: "The application name"},
+			{Name: "Cluster", In: "query", Required: true, Type: "string", Format: "", Description: "The cluster you're operating on"},
+		},
+		ParamsType: reflect.TypeFor[applications.DestroyApplicationParams](),
+		Response:   reflect.TypeFor[applications.ApplicationsApiCommandResponse](),
+	},
+	{
+		ID:       "GetApplicationCatalogItems",
+		Method:   "GET",
+		Path:     "/api/v1/servers/applications/GetApplicationCatalogItems",
+		Tag:      "servers/applications",
+		Package:  "applications",
+		Response: reflect.TypeFor[applications.ListResultDtoOfApplicationsApiCatalogItem](),
+	},
+	{
+		ID:      "GetApplicationDetails",
+		Method:  "GET",
+		Path:    "/api/v1/servers/applications/GetApplicationDetails",
+		Tag:     "servers/applications",
+		Package: "applications",
+		Params: []Param{
+			{Name: "Id", In: "query", Required: true, Type: "string", Format: "", Description: "The application name"},
+			{Name: "Cluster", In: "query", Required: true, Type: "string", Format: "", Description: "The cluster you're operating on"},
+		},
+		ParamsType: reflect.TypeFor[applications.GetApplicationDetailsParams](),
+		Response:   reflect.TypeFor[applications.ApplicationsApiDetails](),
+	},
+	{
+		ID:      "GetApplicationRuntimeLogs",
+		Method:  "GET",
+		Path:    "/api/v1/servers/applications/GetApplicationRuntimeLogs",
+		Tag:     "servers/applications",
+		Package: "applications",
+		Params: []Param{
+			{Name: "Id", In: "query", Required: true, Type: "string", Format: "", Description: "The name of the application"},
+			{Name: "Cluster", In: "query", Required: true, Type: "string", Format: "", Description: "The cluster where the application is running"},
+			{Name: "Limit", In: "query", Required: true, Type: "integer", Format: "int32", Description: "The maximum number of log entries to return."},
+		},
+		ParamsType: reflect.TypeFor[applications.GetApplicationRuntimeLogsParams](),
+		Response:   reflect.TypeFor[applications.ApplicationsApiRuntimeLogsResponse](),
+	},
+	{
+		ID:       "GetApplications",
+		Method:   "GET",
+		Path:     "/api/v1/servers/applications/GetApplications",
+		Tag:      "servers/applications",
+		Package:  "applications",
+		Response: reflect.TypeFor[applications.ListResultDtoOfApplicationsApiOverview](),
+	},
+	{
+		ID:      "GetAvailability",
+		Method:  "GET",
+		Path:    "/api/v1/servers/applications/GetAvailability",
+		Tag:     "servers/applications",
+		Package: "applications",
+		Params: []Param{
+			{Name: "cluster", In: "query", Required: true, Type: "string", Format: "", Description: ""},
+			{Name: "resourcePool", In: "query", Required: true, Type: "string", Format: "", Description: ""},
+		},
+		ParamsType: reflect.TypeFor[applications.GetAvailabilityParams](),
+		Response:   reflect.TypeFor[applications.ListResultDtoOfApplicationsApiApplicationConfigAvailability](),
+	},
+	{
+		ID:       "GetConfigurations",
+		Method:   "GET",
+		Path:     "/api/v1/servers/applications/GetConfigurations",
+		Tag:      "servers/applications",
+		Package:  "applications",
+		Response: reflect.TypeFor[applications.ListResultDtoOfApplicationsApiApplicationConfig](),
+	},
+	{
+		ID:       "StartApplication",
+		Method:   "POST",
+		Path:     "/api/v1/servers/applications/StartApplication",
+		Tag:      "servers/applications",
+		Package:  "applications",
+		Request:  reflect.TypeFor[applications.StartApplicationJSONRequestBody](),
+		Response: reflect.TypeFor[applications.ApplicationsApiCommandResponse](),
+	},
+	{
+		ID:       "StopApplication",
+		Method:   "POST",
+		Path:     "/api/v1/servers/applications/StopApplication",
+		Tag:      "servers/applications",
+		Package:  "applications",
+		Request:  reflect.TypeFor[applications.StopApplicationJSONRequestBody](),
+		Response: reflect.TypeFor[applications.ApplicationsApiCommandResponse](),
+	},
+	{
+		ID:       "CreateServer",
+		Method:   "POST",
+		Path:     "/api/v1/servers/virtual/CreateServer",
+		Tag:      "servers/virtual",
+		Package:  "virtual",
+		Request:  reflect.TypeFor[virtual.CreateServerJSONRequestBody](),
+		Response: reflect.TypeFor[virtual.VirtualServerDetailsItem](),
+	},
+	{
+		ID:      "DestroyServer",
+		Method:  "DELETE",
+		Path:    "/api/v1/servers/virtual/DestroyServer",
+		Tag:     "servers/virtual",
+		Package: "virtual",
+		Params: []Param{
+			{Name: "DeleteSnapshots", In: "query", Required: false, Type: "boolean", Format: "", Description: "Should also delete snapshots with virtual machine."},
+			{Name: "Id", In: "query", Required: true, Type: "string", Format: "", Description: "The virtual machine id"},
+			{Name: "Namespace", In: "query", Required: true, Type: "string", Format: "", Description: "The namespace/vpc where the virtual machine lives. Default one is same as tenant name."},
+			{Name: "Cluster", In: "query", Required: true, Type: "string", Format: "", Description: "The cluster you're operating on"},
+		},
+		ParamsType: reflect.TypeFor[virtual.DestroyServerParams](),
+		Response:   reflect.TypeFor[virtual.ServerCommandOutput](),
+	},
+	{
+		ID:      "GetAvailability",
+		Method:  "GET",
+		Path:    "/api/v1/servers/virtual/GetAvailability",
+		Tag:     "servers/virtual",
+		Package: "virtual",
+		Params: []Param{
+			{Name: "cluster", In: "query", Required: true, Type: "string", Format: "", Description: ""},
+			{Name: "resourcePool", In: "query", Required: false, Type: "string", Format: "", Description: ""},
+			{Name: "reportNodes", In: "query", Required: false, Type: "boolean", Format: "", Description: "controls if Count and MaxCount is calculated and returned in the response. If they are not needed, use 'false' to improve response time of the endpoint."},
+		},
+		ParamsType: reflect.TypeFor[virtual.GetAvailabilityParams](),
+		Response:   reflect.TypeFor[virtual.ListResultDtoOfServerAvailability](),
+	},
+	{
+		ID:       "GetConfigurations",
+		Method:   "GET",
+		Path:     "/api/v1/servers/virtual/GetConfigurations",
+		Tag:      "servers/virtual",
+		Package:  "virtual",
+		Response: reflect.TypeFor[virtual.ListResultDtoOfServerConfiguration](),
+	},
+	{
+		ID:      "GetServer",
+		Method:  "GET",
+		Path:    "/api/v1/servers/virtual/GetServer",
+		Tag:     "servers/virtual",
+		Package: "virtual",
+		Params: []Param{
+			{Name: "Id", In: "query", Required: true, Type: "string", Format: "", Description: "The virtual machine id"},
+			{Name: "Namespace", In: "query", Required: true, Type: "string", Format: "", Description: "The namespace/vpc where the virtual machine lives. Default one is same as tenant name."},
+			{Name: "Cluster", In: "query", Required: true, Type: "string", Format: "", Description: "The cluster you're operating on"},
+		},
+		ParamsType: reflect.TypeFor[virtual.GetServerParams](),
+		Response:   reflect.TypeFor[virtual.VirtualServerDetailsItem](),
+	},
+	{
+		ID:      "GetServers",
+		Method:  "GET",
+		Path:    "/api/v1/servers/virtual/GetServers",
+		Tag:     "servers/virtual",
+		Package: "virtual",
+		Params: []Param{
+			{Name: "Cluster", In: "query", Required: false, Type: "string", Format: "", Description: ""},
+		},
+		ParamsType: reflect.TypeFor[virtual.GetServersParams](),
+		Response:   reflect.TypeFor[virtual.ListResultDtoOfVirtualServerDetailsItem](),
+	},
+	{
+		ID:      "GetVirtualMachineBootLogs",
+		Method:  "GET",
+		Path:    "/api/v1/servers/virtual/GetVirtualMachineBootLogs",
+		Tag:     "servers/virtual",
+		Package: "virtual",
+		Params: []Param{
+			{Name: "Id", In: "query", Required: true, Type: "string", Format: "", Description: "The virtual machine id"},
+			{Name: "Namespace", In: "query", Required: true, Type: "string", Format: "", Description: "The namespace/vpc where the virtual machine lives. Default one is same as tenant name."},
+			{Name: "Cluster", In: "query", Required: true, Type: "string", Format: "", Description: "The cluster you're operating on"},
+			{Name: "Limit", In: "query", Required: true, Type: "integer", Format: "int32", Description: "The maximum number of log entries to return. Defaults to 2000."},
+		},
+		ParamsType: reflect.TypeFor[virtual.GetVirtualMachineBootLogsParams](),
+		Response:   reflect.TypeFor[virtual.ServerBootLogsOutput](),
+	},
+	{
+		ID:       "StartServer",
+		Method:   "POST",
+		Path:     "/api/v1/servers/virtual/StartServer",
+		Tag:      "servers/virtual",
+		Package:  "virtual",
+		Request:  reflect.TypeFor[virtual.StartServerJSONRequestBody](),
+		Response: reflect.TypeFor[virtual.ServerCommandOutput](),
+	},
+	{
+		ID:       "StopServer",
+		Method:   "POST",
+		Path:     "/api/v1/servers/virtual/StopServer",
+		Tag:      "servers/virtual",
+		Package:  "virtual",
+		Request:  reflect.TypeFor[virtual.StopServerJSONRequestBody](),
+		Response: reflect.TypeFor[virtual.ServerCommandOutput](),
+	},
+}
@@ -0,0 +1,79 @@
+// Package registry lists the operations in the embedded spec along with the generated types used to call them,
+// for tools like the denvr command, docs and validation.
+//
+// Usage:
+//
+//	op, ok := registry.Lookup("servers/virtual", "GetServer")
+//	fmt.Println(op.Method, op.Path, op.Response)
+package registry
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Operation describes an API operation in the spec, along with the generated Go types used to call it.
+type Operation struct {
+	// ID is the operationId, which is only unique within a Tag
+	ID     string
+	Method string
+	Path   string
+	Tag    string
+	// Package is the generated package for the tag (e.g., "virtual"), and empty if it isn't generated
+	Package string
+	// Params describes the query parameters in the order they're declared in the spec
+	Params []Param
+	// ParamsType is the generated <ID>Params struct, and nil if the operation has no parameters
+	ParamsType reflect.Type
+	// Request is the generated JSON request body type, and nil if the operation has no body
+	Request reflect.Type
+	// Response is the generated type of a successful response
+	Response   reflect.Type
+	Deprecated bool
+}
+
+// Param is a parameter of an operation.
+type Param struct {
+	Name        string
+	In          string
+	Required    bool
+	Type        string
+	Format      string
+	Description string
+}
+
+// Lookup returns the operation with the given tag and operationId (e.g., "servers/virtual", "GetServer").
+func Lookup(tag, id string) (Operation, bool) {
+	for _, op := range Operations {
+		if op.Tag == tag && op.ID == id {
+			return op, true
+		}
+	}
+	return Operation{}, false
+}
+
+// Find returns the operation for a request method and path. The path may include a server prefix.
+func Find(method, path string) (Operation, bool) {
+	for _, match := range []func(string) bool{
+		func(p string) bool { return p == path },
+		func(p string) bool { return strings.HasSuffix(path, p) },
+	} {
+		for _, op := range Operations {
+			if strings.EqualFold(op.Method, method) && match(op.Path) {
+				return op, true
+			}
+		}
+	}
+	return Operation{}, false
+}
+
+// Tagged returns the operations with the given tag.
+func Tagged(tag string) []Operation {
+	var ops []Operation
+	for _, op := range Operations {
+		if op.Tag == tag {
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
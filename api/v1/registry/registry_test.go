@@ -0,0 +1,73 @@
+package registry_test
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "github.com/denvrdata/go-denvr/api/v1"
+	"github.com/denvrdata/go-denvr/api/v1/registry"
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookup(t *testing.T) {
+	op, ok := registry.Lookup("servers/virtual", "GetAvailability")
+	require.True(t, ok)
+	assert.Equal(t, "GET", op.Method)
+	assert.Equal(t, "/api/v1/servers/virtual/GetAvailability", op.Path)
+	assert.Equal(t, "virtual", op.Package)
+	assert.Equal(t, reflect.TypeFor[virtual.GetAvailabilityParams](), op.ParamsType)
+	assert.Equal(t, reflect.TypeFor[virtual.ListResultDtoOfServerAvailability](), op.Response)
+	assert.Nil(t, op.Request)
+	require.Len(t, op.Params, 3)
+	assert.Equal(t, registry.Param{Name: "cluster", In: "query", Required: true, Type: "string"}, op.Params[0])
+
+	op, ok = registry.Lookup("servers/applications", "GetAvailability")
+	require.True(t, ok)
+	assert.Equal(t, reflect.TypeFor[applications.GetAvailabilityParams](), op.ParamsType)
+
+	_, ok = registry.Lookup("servers/metal", "GetHosts")
+	assert.False(t, ok)
+}
+
+func TestFind(t *testing.T) {
+	op, ok := registry.Find("post", "/dev/api/v1/servers/virtual/CreateServer")
+	require.True(t, ok)
+	assert.Equal(t, "CreateServer", op.ID)
+	assert.Equal(t, reflect.TypeFor[virtual.CreateVirtualServerInput](), op.Request)
+
+	_, ok = registry.Find("GET", "/api/v1/servers/virtual/CreateServer")
+	assert.False(t, ok)
+}
+
+// TestClients checks every registered operation against the generated client methods.
+func TestClients(t *testing.T) {
+	clients := map[string]reflect.Type{
+		"virtual":      reflect.TypeFor[*virtual.Client](),
+		"applications": reflect.TypeFor[*applications.Client](),
+	}
+	doc, err := v1.Load()
+	require.NoError(t, err)
+
+	count := 0
+	for _, tag := range []string{"servers/virtual", "servers/applications"} {
+		for _, op := range registry.Tagged(tag) {
+			count++
+			client := clients[op.Package]
+			require.NotNil(t, client, op.ID)
+			method, ok := client.MethodByName(op.ID)
+			require.True(t, ok, op.ID)
+			assert.Equal(t, op.Response, method.Type.Out(0).Elem(), op.ID)
+			if op.Request != nil {
+				assert.Equal(t, op.Request, method.Type.In(2), op.ID)
+			}
+			if op.ParamsType != nil {
+				assert.Equal(t, op.ParamsType, method.Type.In(2).Elem(), op.ID)
+			}
+			assert.NotNil(t, doc.Paths.Find(op.Path).GetOperation(op.Method), op.ID)
+		}
+	}
+	assert.Equal(t, len(registry.Operations), count)
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"net/url"
 
@@ -16,37 +17,128 @@ import (
 
 	// "github.com/hashicorp/go-retryablehttp"
 
+	"github.com/denvrdata/go-denvr/api/v1/models"
 	"github.com/denvrdata/go-denvr/config"
 	"github.com/denvrdata/go-denvr/response"
 )
 
 // ApplicationsApiApplicationConfig defines model for ApplicationsApiApplicationConfig.
 type ApplicationsApiApplicationConfig struct {
-	Clusters                *[]string `json:"clusters"`
-	Description             *string   `json:"description"`
-	DirectAttachedStorageGb *int32    `json:"directAttachedStorageGb"`
-	GpuBrand                *string   `json:"gpuBrand"`
-	GpuCount                *int32    `json:"gpuCount"`
-	GpuName                 *string   `json:"gpuName"`
-	GpuType                 *string   `json:"gpuType"`
-	MemoryGb                *int64    `json:"memoryGb"`
-	Name                    *string   `json:"name"`
-	PricePerHour            *float64  `json:"pricePerHour"`
-	VcpusCount              *int32    `json:"vcpusCount"`
+	Clusters                *[]string         `json:"clusters"`
+	Description             *string           `json:"description"`
+	DirectAttachedStorageGb *models.Gigabytes `json:"directAttachedStorageGb"`
+	GpuBrand                *string           `json:"gpuBrand"`
+	GpuCount                *int32            `json:"gpuCount"`
+	GpuName                 *string           `json:"gpuName"`
+	GpuType                 *string           `json:"gpuType"`
+	MemoryGb                *models.Gigabytes `json:"memoryGb"`
+	Name                    *string           `json:"name"`
+	PricePerHour            *float64          `json:"pricePerHour"`
+	VcpusCount              *int32            `json:"vcpusCount"`
 }
 
-// ApplicationsApiApplicationConfigAvailability defines model for ApplicationsApiApplicationConfigAvailability.
-type ApplicationsApiApplicationConfigAvailability struct {
-	Available          *bool     `json:"available"`
-	AvailableNodeNames *[]string `json:"availableNodeNames"`
-	Cluster            *string   `json:"cluster"`
-	Configuration      *string   `json:"configuration"`
-	Count              *int32    `json:"count"`
-	MaxCount           *int32    `json:"maxCount"`
-	Price              *float64  `json:"price"`
-	Rpool              *string   `json:"rpool"`
+// GetClusters returns the Clusters field value if set, zero value otherwise.
+func (v *ApplicationsApiApplicationConfig) GetClusters() []string {
+	if v == nil || v.Clusters == nil {
+		var zero []string
+		return zero
+	}
+	return *v.Clusters
+}
+
+// GetDescription returns the Description field value if set, zero value otherwise.
+func (v *ApplicationsApiApplicationConfig) GetDescription() string {
+	if v == nil || v.Description == nil {
+		var zero string
+		return zero
+	}
+	return *v.Description
+}
+
+// GetDirectAttachedStorageGb returns the DirectAttachedStorageGb field value if set, zero value otherwise.
+func (v *ApplicationsApiApplicationConfig) GetDirectAttachedStorageGb() models.Gigabytes {
+	if v == nil || v.DirectAttachedStorageGb == nil {
+		var zero models.Gigabytes
+		return zero
+	}
+	return *v.DirectAttachedStorageGb
+}
+
+// GetGpuBrand returns the GpuBrand field value if set, zero value otherwise.
+func (v *ApplicationsApiApplicationConfig) GetGpuBrand() string {
+	if v == nil || v.GpuBrand == nil {
+		var zero string
+		return zero
+	}
+	return *v.GpuBrand
+}
+
+// GetGpuCount returns the GpuCount field value if set, zero value otherwise.
+func (v *ApplicationsApiApplicationConfig) GetGpuCount() int32 {
+	if v == nil || v.GpuCount == nil {
+		var zero int32
+		return zero
+	}
+	return *v.GpuCount
+}
+
+// GetGpuName returns the GpuName field value if set, zero value otherwise.
+func (v *ApplicationsApiApplicationConfig) GetGpuName() string {
+	if v == nil || v.GpuName == nil {
+		var zero string
+		return zero
+	}
+	return *v.GpuName
+}
+
+// GetGpuType returns the GpuType field value if set, zero value otherwise.
+func (v *ApplicationsApiApplicationConfig) GetGpuType() string {
+	if v == nil || v.GpuType == nil {
+		var zero string
+		return zero
+	}
+	return *v.GpuType
+}
+
+// GetMemoryGb returns the MemoryGb field value if set, zero value otherwise.
+func (v *ApplicationsApiApplicationConfig) GetMemoryGb() models.Gigabytes {
+	if v == nil || v.MemoryGb == nil {
+		var zero models.Gigabytes
+		return zero
+	}
+	return *v.MemoryGb
 }
 
+// GetName returns the Name field value if set, zero value otherwise.
+func (v *ApplicationsApiApplicationConfig) GetName() string {
+	if v == nil || v.Name == nil {
+		var zero string
+		return zero
+	}
+	return *v.Name
+}
+
+// GetPricePerHour returns the PricePerHour field value if set, zero value otherwise.
+func (v *ApplicationsApiApplicationConfig) GetPricePerHour() float64 {
+	if v == nil || v.PricePerHour == nil {
+		var zero float64
+		return zero
+	}
+	return *v.PricePerHour
+}
+
+// GetVcpusCount returns the VcpusCount field value if set, zero value otherwise.
+func (v *ApplicationsApiApplicationConfig) GetVcpusCount() int32 {
+	if v == nil || v.VcpusCount == nil {
+		var zero int32
+		return zero
+	}
+	return *v.VcpusCount
+}
+
+// ApplicationsApiApplicationConfigAvailability defines model for ApplicationsApiApplicationConfigAvailability.
+type ApplicationsApiApplicationConfigAvailability = models.Availability
+
 // ApplicationsApiCatalogItem defines model for ApplicationsApiCatalogItem.
 type ApplicationsApiCatalogItem struct {
 	ApplicationSourceDetailsUrl *string                              `json:"applicationSourceDetailsUrl"`
@@ -55,15 +147,114 @@ type ApplicationsApiCatalogItem struct {
 	Versions                    *[]ApplicationsApiCatalogItemVersion `json:"versions"`
 }
 
+// GetApplicationSourceDetailsUrl returns the ApplicationSourceDetailsUrl field value if set, zero value otherwise.
+func (v *ApplicationsApiCatalogItem) GetApplicationSourceDetailsUrl() string {
+	if v == nil || v.ApplicationSourceDetailsUrl == nil {
+		var zero string
+		return zero
+	}
+	return *v.ApplicationSourceDetailsUrl
+}
+
+// GetApplicationSourceOwner returns the ApplicationSourceOwner field value if set, zero value otherwise.
+func (v *ApplicationsApiCatalogItem) GetApplicationSourceOwner() string {
+	if v == nil || v.ApplicationSourceOwner == nil {
+		var zero string
+		return zero
+	}
+	return *v.ApplicationSourceOwner
+}
+
+// GetName returns the Name field value if set, zero value otherwise.
+func (v *ApplicationsApiCatalogItem) GetName() string {
+	if v == nil || v.Name == nil {
+		var zero string
+		return zero
+	}
+	return *v.Name
+}
+
+// GetVersions returns the Versions field value if set, zero value otherwise.
+func (v *ApplicationsApiCatalogItem) GetVersions() []ApplicationsApiCatalogItemVersion {
+	if v == nil || v.Versions == nil {
+		var zero []ApplicationsApiCatalogItemVersion
+		return zero
+	}
+	return *v.Versions
+}
+
 // ApplicationsApiCatalogItemVersion defines model for ApplicationsApiCatalogItemVersion.
 type ApplicationsApiCatalogItemVersion struct {
-	Accelerator       *string `json:"accelerator"`
-	ImageLastPushDate *string `json:"imageLastPushDate"`
-	ImageUrl          *string `json:"imageUrl"`
-	LaunchType        *string `json:"launchType"`
-	Name              *string `json:"name"`
-	Platform          *string `json:"platform"`
-	ReleaseNotesUrl   *string `json:"releaseNotesUrl"`
+	Accelerator       *string      `json:"accelerator"`
+	ImageLastPushDate *models.Time `json:"imageLastPushDate"`
+	ImageUrl          *string      `json:"imageUrl"`
+	LaunchType        *string      `json:"launchType"`
+	Name              *string      `json:"name"`
+	Platform          *string      `json:"platform"`
+	ReleaseNotesUrl   *string      `json:"releaseNotesUrl"`
+}
+
+// GetAccelerator returns the Accelerator field value if set, zero value otherwise.
+func (v *ApplicationsApiCatalogItemVersion) GetAccelerator() string {
+	if v == nil || v.Accelerator == nil {
+		var zero string
+		return zero
+	}
+	return *v.Accelerator
+}
+
+// GetImageLastPushDate returns the ImageLastPushDate field value if set, zero value otherwise.
+func (v *ApplicationsApiCatalogItemVersion) GetImageLastPushDate() models.Time {
+	if v == nil || v.ImageLastPushDate == nil {
+		var zero models.Time
+		return zero
+	}
+	return *v.ImageLastPushDate
+}
+
+// GetImageUrl returns the ImageUrl field value if set, zero value otherwise.
+func (v *ApplicationsApiCatalogItemVersion) GetImageUrl() string {
+	if v == nil || v.ImageUrl == nil {
+		var zero string
+		return zero
+	}
+	return *v.ImageUrl
+}
+
+// GetLaunchType returns the LaunchType field value if set, zero value otherwise.
+func (v *ApplicationsApiCatalogItemVersion) GetLaunchType() string {
+	if v == nil || v.LaunchType == nil {
+		var zero string
+		return zero
+	}
+	return *v.LaunchType
+}
+
+// GetName returns the Name field value if set, zero value otherwise.
+func (v *ApplicationsApiCatalogItemVersion) GetName() string {
+	if v == nil || v.Name == nil {
+		var zero string
+		return zero
+	}
+	return *v.Name
+}
+
+// GetPlatform returns the Platform field value if set, zero value otherwise.
+func (v *ApplicationsApiCatalogItemVersion) GetPlatform() string {
+	if v == nil || v.Platform == nil {
+		var zero string
+		return zero
+	}
+	return *v.Platform
+}
+
+// GetReleaseNotesUrl returns the ReleaseNotesUrl field value if set, zero value otherwise.
+func (v *ApplicationsApiCatalogItemVersion) GetReleaseNotesUrl() string {
+	if v == nil || v.ReleaseNotesUrl == nil {
+		var zero string
+		return zero
+	}
+	return *v.ReleaseNotesUrl
 }
 
 // ApplicationsApiCommandRequest defines model for ApplicationsApiCommandRequest.
@@ -75,6 +266,24 @@ type ApplicationsApiCommandRequest struct {
 	Id string `json:"id"`
 }
 
+// GetCluster returns the Cluster field value if set, zero value otherwise.
+func (v *ApplicationsApiCommandRequest) GetCluster() string {
+	if v == nil {
+		var zero string
+		return zero
+	}
+	return v.Cluster
+}
+
+// GetId returns the Id field value if set, zero value otherwise.
+func (v *ApplicationsApiCommandRequest) GetId() string {
+	if v == nil {
+		var zero string
+		return zero
+	}
+	return v.Id
+}
+
 // ApplicationsApiCommandResponse defines model for ApplicationsApiCommandResponse.
 type ApplicationsApiCommandResponse struct {
 	// Cluster The cluster you're operating on
@@ -84,6 +293,24 @@ type ApplicationsApiCommandResponse struct {
 	Id string `json:"id"`
 }
 
+// GetCluster returns the Cluster field value if set, zero value otherwise.
+func (v *ApplicationsApiCommandResponse) GetCluster() string {
+	if v == nil {
+		var zero string
+		return zero
+	}
+	return v.Cluster
+}
+
+// GetId returns the Id field value if set, zero value otherwise.
+func (v *ApplicationsApiCommandResponse) GetId() string {
+	if v == nil {
+		var zero string
+		return zero
+	}
+	return v.Id
+}
+
 // ApplicationsApiCreateRequest defines model for ApplicationsApiCreateRequest.
 type ApplicationsApiCreateRequest struct {
 	// ApplicationCatalogItemName The name of the application catalog item.
@@ -143,6 +370,150 @@ type ApplicationsApiCreateRequest struct {
 	TenantSharedStorage *bool `json:"tenantSharedStorage,omitempty"`
 }
 
+// GetApplicationCatalogItemName returns the ApplicationCatalogItemName field value if set, zero value otherwise.
+func (v *ApplicationsApiCreateRequest) GetApplicationCatalogItemName() string {
+	if v == nil {
+		var zero string
+		return zero
+	}
+	return v.ApplicationCatalogItemName
+}
+
+// GetApplicationCatalogItemVersion returns the ApplicationCatalogItemVersion field value if set, zero value otherwise.
+func (v *ApplicationsApiCreateRequest) GetApplicationCatalogItemVersion() string {
+	if v == nil {
+		var zero string
+		return zero
+	}
+	return v.ApplicationCatalogItemVersion
+}
+
+// GetCluster returns the Cluster field value if set, zero value otherwise.
+func (v *ApplicationsApiCreateRequest) GetCluster() string {
+	if v == nil {
+		var zero string
+		return zero
+	}
+	return v.Cluster
+}
+
+// GetEnvironmentVariables returns the EnvironmentVariables field value if set, zero value otherwise.
+func (v *ApplicationsApiCreateRequest) GetEnvironmentVariables() map[string]*string {
+	if v == nil || v.EnvironmentVariables == nil {
+		var zero map[string]*string
+		return zero
+	}
+	return *v.EnvironmentVariables
+}
+
+// GetHardwarePackageName returns the HardwarePackageName field value if set, zero value otherwise.
+func (v *ApplicationsApiCreateRequest) GetHardwarePackageName() string {
+	if v == nil {
+		var zero string
+		return zero
+	}
+	return v.HardwarePackageName
+}
+
+// GetJupyterToken returns the JupyterToken field value if set, zero value otherwise.
+func (v *ApplicationsApiCreateRequest) GetJupyterToken() string {
+	if v == nil || v.JupyterToken == nil {
+		var zero string
+		return zero
+	}
+	return *v.JupyterToken
+}
+
+// GetName returns the Name field value if set, zero value otherwise.
+func (v *ApplicationsApiCreateRequest) GetName() string {
+	if v == nil {
+		var zero string
+		return zero
+	}
+	return v.Name
+}
+
+// GetPersistDirectAttachedStorage returns the PersistDirectAttachedStorage field value if set, zero value otherwise.
+func (v *ApplicationsApiCreateRequest) GetPersistDirectAttachedStorage() bool {
+	if v == nil || v.PersistDirectAttachedStorage == nil {
+		var zero bool
+		return zero
+	}
+	return *v.PersistDirectAttachedStorage
+}
+
+// GetPersonalSharedStorage returns the PersonalSharedStorage field value if set, zero value otherwise.
+func (v *ApplicationsApiCreateRequest) GetPersonalSharedStorage() bool {
+	if v == nil || v.PersonalSharedStorage == nil {
+		var zero bool
+		return zero
+	}
+	return *v.PersonalSharedStorage
+}
+
+// GetProxyApiKeys returns the ProxyApiKeys field value if set, zero value otherwise.
+func (v *ApplicationsApiCreateRequest) GetProxyApiKeys() []string {
+	if v == nil || v.ProxyApiKeys == nil {
+		var zero []string
+		return zero
+	}
+	return *v.ProxyApiKeys
+}
+
+// GetProxyPort returns the ProxyPort field value if set, zero value otherwise.
+func (v *ApplicationsApiCreateRequest) GetProxyPort() string {
+	if v == nil || v.ProxyPort == nil {
+		var zero string
+		return zero
+	}
+	return *v.ProxyPort
+}
+
+// GetResourcePool returns the ResourcePool field value if set, zero value otherwise.
+func (v *ApplicationsApiCreateRequest) GetResourcePool() string {
+	if v == nil || v.ResourcePool == nil {
+		var zero string
+		return zero
+	}
+	return *v.ResourcePool
+}
+
+// GetSelectedNode returns the SelectedNode field value if set, zero value otherwise.
+func (v *ApplicationsApiCreateRequest) GetSelectedNode() string {
+	if v == nil || v.SelectedNode == nil {
+		var zero string
+		return zero
+	}
+	return *v.SelectedNode
+}
+
+// GetSshKeys returns the SshKeys field value if set, zero value otherwise.
+func (v *ApplicationsApiCreateRequest) GetSshKeys() []string {
+	if v == nil || v.SshKeys == nil {
+		var zero []string
+		return zero
+	}
+	return *v.SshKeys
+}
+
+// GetStartupCommands returns the StartupCommands field value if set, zero value otherwise.
+func (v *ApplicationsApiCreateRequest) GetStartupCommands() []string {
+	if v == nil || v.StartupCommands == nil {
+		var zero []string
+		return zero
+	}
+	return *v.StartupCommands
+}
+
+// GetTenantSharedStorage returns the TenantSharedStorage field value if set, zero value otherwise.
+func (v *ApplicationsApiCreateRequest) GetTenantSharedStorage() bool {
+	if v == nil || v.TenantSharedStorage == nil {
+		var zero bool
+		return zero
+	}
+	return *v.TenantSharedStorage
+}
+
 // ApplicationsApiCustomApiCreateRequest defines model for ApplicationsApiCustomApiCreateRequest.
 type ApplicationsApiCustomApiCreateRequest struct {
 	// Cluster The cluster you're operating on
@@ -215,6 +586,159 @@ type ApplicationsApiCustomApiCreateRequest struct {
 	UserScripts *map[string]*string `json:"userScripts"`
 }
 
+// GetCluster returns the Cluster field value if set, zero value otherwise.
+func (v *ApplicationsApiCustomApiCreateRequest) GetCluster() string {
+	if v == nil {
+		var zero string
+		return zero
+	}
+	return v.Cluster
+}
+
+// GetEnvironmentVariables returns the EnvironmentVariables field value if set, zero value otherwise.
+func (v *ApplicationsApiCustomApiCreateRequest) GetEnvironmentVariables() map[string]*string {
+	if v == nil || v.EnvironmentVariables == nil {
+		var zero map[string]*string
+		return zero
+	}
+	return *v.EnvironmentVariables
+}
+
+// GetHardwarePackageName returns the HardwarePackageName field value if set, zero value otherwise.
+func (v *ApplicationsApiCustomApiCreateRequest) GetHardwarePackageName() string {
+	if v == nil {
+		var zero string
+		return zero
+	}
+	return v.HardwarePackageName
+}
+
+// GetImageCmdOverride returns the ImageCmdOverride field value if set, zero value otherwise.
+func (v *ApplicationsApiCustomApiCreateRequest) GetImageCmdOverride() []string {
+	if v == nil || v.ImageCmdOverride == nil {
+		var zero []string
+		return zero
+	}
+	return *v.ImageCmdOverride
+}
+
+// GetImageRepository returns the ImageRepository field value if set, zero value otherwise.
+func (v *ApplicationsApiCustomApiCreateRequest) GetImageRepository() ImageRepositoryDto {
+	if v == nil || v.ImageRepository == nil {
+		var zero ImageRepositoryDto
+		return zero
+	}
+	return *v.ImageRepository
+}
+
+// GetImageUrl returns the ImageUrl field value if set, zero value otherwise.
+func (v *ApplicationsApiCustomApiCreateRequest) GetImageUrl() string {
+	if v == nil {
+		var zero string
+		return zero
+	}
+	return v.ImageUrl
+}
+
+// GetName returns the Name field value if set, zero value otherwise.
+func (v *ApplicationsApiCustomApiCreateRequest) GetName() string {
+	if v == nil {
+		var zero string
+		return zero
+	}
+	return v.Name
+}
+
+// GetPersistDirectAttachedStorage returns the PersistDirectAttachedStorage field value if set, zero value otherwise.
+func (v *ApplicationsApiCustomApiCreateRequest) GetPersistDirectAttachedStorage() bool {
+	if v == nil || v.PersistDirectAttachedStorage == nil {
+		var zero bool
+		return zero
+	}
+	return *v.PersistDirectAttachedStorage
+}
+
+// GetPersonalSharedStorage returns the PersonalSharedStorage field value if set, zero value otherwise.
+func (v *ApplicationsApiCustomApiCreateRequest) GetPersonalSharedStorage() bool {
+	if v == nil || v.PersonalSharedStorage == nil {
+		var zero bool
+		return zero
+	}
+	return *v.PersonalSharedStorage
+}
+
+// GetProxyApiKeys returns the ProxyApiKeys field value if set, zero value otherwise.
+func (v *ApplicationsApiCustomApiCreateRequest) GetProxyApiKeys() []string {
+	if v == nil || v.ProxyApiKeys == nil {
+		var zero []string
+		return zero
+	}
+	return *v.ProxyApiKeys
+}
+
+// GetProxyPort returns the ProxyPort field value if set, zero value otherwise.
+func (v *ApplicationsApiCustomApiCreateRequest) GetProxyPort() int32 {
+	if v == nil || v.ProxyPort == nil {
+		var zero int32
+		return zero
+	}
+	return *v.ProxyPort
+}
+
+// GetReadinessWatcherPort returns the ReadinessWatcherPort field value if set, zero value otherwise.
+func (v *ApplicationsApiCustomApiCreateRequest) GetReadinessWatcherPort() int32 {
+	if v == nil || v.ReadinessWatcherPort == nil {
+		var zero int32
+		return zero
+	}
+	return *v.ReadinessWatcherPort
+}
+
+// GetResourcePool returns the ResourcePool field value if set, zero value otherwise.
+func (v *ApplicationsApiCustomApiCreateRequest) GetResourcePool() string {
+	if v == nil || v.ResourcePool == nil {
+		var zero string
+		return zero
+	}
+	return *v.ResourcePool
+}
+
+// GetSecurityContext returns the SecurityContext field value if set, zero value otherwise.
+func (v *ApplicationsApiCustomApiCreateRequest) GetSecurityContext() SecurityContextDto {
+	if v == nil || v.SecurityContext == nil {
+		var zero SecurityContextDto
+		return zero
+	}
+	return *v.SecurityContext
+}
+
+// GetSelectedNode returns the SelectedNode field value if set, zero value otherwise.
+func (v *ApplicationsApiCustomApiCreateRequest) GetSelectedNode() string {
+	if v == nil || v.SelectedNode == nil {
+		var zero string
+		return zero
+	}
+	return *v.SelectedNode
+}
+
+// GetTenantSharedStorage returns the TenantSharedStorage field value if set, zero value otherwise.
+func (v *ApplicationsApiCustomApiCreateRequest) GetTenantSharedStorage() bool {
+	if v == nil || v.TenantSharedStorage == nil {
+		var zero bool
+		return zero
+	}
+	return *v.TenantSharedStorage
+}
+
+// GetUserScripts returns the UserScripts field value if set, zero value otherwise.
+func (v *ApplicationsApiCustomApiCreateRequest) GetUserScripts() map[string]*string {
+	if v == nil || v.UserScripts == nil {
+		var zero map[string]*string
+		return zero
+	}
+	return *v.UserScripts
+}
+
 // ApplicationsApiDetails defines model for ApplicationsApiDetails.
 type ApplicationsApiDetails struct {
 	ApplicationCatalogItem *ApplicationsApiCatalogItem       `json:"applicationCatalogItem,omitempty"`
@@ -222,6 +746,33 @@ type ApplicationsApiDetails struct {
 	InstanceDetails        *InstanceDetails                  `json:"instanceDetails,omitempty"`
 }
 
+// GetApplicationCatalogItem returns the ApplicationCatalogItem field value if set, zero value otherwise.
+func (v *ApplicationsApiDetails) GetApplicationCatalogItem() ApplicationsApiCatalogItem {
+	if v == nil || v.ApplicationCatalogItem == nil {
+		var zero ApplicationsApiCatalogItem
+		return zero
+	}
+	return *v.ApplicationCatalogItem
+}
+
+// GetHardwarePackage returns the HardwarePackage field value if set, zero value otherwise.
+func (v *ApplicationsApiDetails) GetHardwarePackage() ApplicationsApiApplicationConfig {
+	if v == nil || v.HardwarePackage == nil {
+		var zero ApplicationsApiApplicationConfig
+		return zero
+	}
+	return *v.HardwarePackage
+}
+
+// GetInstanceDetails returns the InstanceDetails field value if set, zero value otherwise.
+func (v *ApplicationsApiDetails) GetInstanceDetails() InstanceDetails {
+	if v == nil || v.InstanceDetails == nil {
+		var zero InstanceDetails
+		return zero
+	}
+	return *v.InstanceDetails
+}
+
 // ApplicationsApiOverview defines model for ApplicationsApiOverview.
 type ApplicationsApiOverview struct {
 	ApplicationCatalogItemName        *string `json:"applicationCatalogItemName"`
@@ -242,6 +793,150 @@ type ApplicationsApiOverview struct {
 	TenantSharedStorage               *bool   `json:"tenantSharedStorage,omitempty"`
 }
 
+// GetApplicationCatalogItemName returns the ApplicationCatalogItemName field value if set, zero value otherwise.
+func (v *ApplicationsApiOverview) GetApplicationCatalogItemName() string {
+	if v == nil || v.ApplicationCatalogItemName == nil {
+		var zero string
+		return zero
+	}
+	return *v.ApplicationCatalogItemName
+}
+
+// GetApplicationCatalogItemVersionName returns the ApplicationCatalogItemVersionName field value if set, zero value otherwise.
+func (v *ApplicationsApiOverview) GetApplicationCatalogItemVersionName() string {
+	if v == nil || v.ApplicationCatalogItemVersionName == nil {
+		var zero string
+		return zero
+	}
+	return *v.ApplicationCatalogItemVersionName
+}
+
+// GetCluster returns the Cluster field value if set, zero value otherwise.
+func (v *ApplicationsApiOverview) GetCluster() string {
+	if v == nil || v.Cluster == nil {
+		var zero string
+		return zero
+	}
+	return *v.Cluster
+}
+
+// GetCreatedBy returns the CreatedBy field value if set, zero value otherwise.
+func (v *ApplicationsApiOverview) GetCreatedBy() string {
+	if v == nil || v.CreatedBy == nil {
+		var zero string
+		return zero
+	}
+	return *v.CreatedBy
+}
+
+// GetDns returns the Dns field value if set, zero value otherwise.
+func (v *ApplicationsApiOverview) GetDns() string {
+	if v == nil || v.Dns == nil {
+		var zero string
+		return zero
+	}
+	return *v.Dns
+}
+
+// GetHardwarePackageName returns the HardwarePackageName field value if set, zero value otherwise.
+func (v *ApplicationsApiOverview) GetHardwarePackageName() string {
+	if v == nil || v.HardwarePackageName == nil {
+		var zero string
+		return zero
+	}
+	return *v.HardwarePackageName
+}
+
+// GetId returns the Id field value if set, zero value otherwise.
+func (v *ApplicationsApiOverview) GetId() string {
+	if v == nil || v.Id == nil {
+		var zero string
+		return zero
+	}
+	return *v.Id
+}
+
+// GetPersistedDirectAttachedStorage returns the PersistedDirectAttachedStorage field value if set, zero value otherwise.
+func (v *ApplicationsApiOverview) GetPersistedDirectAttachedStorage() bool {
+	if v == nil || v.PersistedDirectAttachedStorage == nil {
+		var zero bool
+		return zero
+	}
+	return *v.PersistedDirectAttachedStorage
+}
+
+// GetPersonalSharedStorage returns the PersonalSharedStorage field value if set, zero value otherwise.
+func (v *ApplicationsApiOverview) GetPersonalSharedStorage() bool {
+	if v == nil || v.PersonalSharedStorage == nil {
+		var zero bool
+		return zero
+	}
+	return *v.PersonalSharedStorage
+}
+
+// GetPrivateIp returns the PrivateIp field value if set, zero value otherwise.
+func (v *ApplicationsApiOverview) GetPrivateIp() string {
+	if v == nil || v.PrivateIp == nil {
+		var zero string
+		return zero
+	}
+	return *v.PrivateIp
+}
+
+// GetPublicIp returns the PublicIp field value if set, zero value otherwise.
+func (v *ApplicationsApiOverview) GetPublicIp() string {
+	if v == nil || v.PublicIp == nil {
+		var zero string
+		return zero
+	}
+	return *v.PublicIp
+}
+
+// GetResourcePool returns the ResourcePool field value if set, zero value otherwise.
+func (v *ApplicationsApiOverview) GetResourcePool() string {
+	if v == nil || v.ResourcePool == nil {
+		var zero string
+		return zero
+	}
+	return *v.ResourcePool
+}
+
+// GetSshUsername returns the SshUsername field value if set, zero value otherwise.
+func (v *ApplicationsApiOverview) GetSshUsername() string {
+	if v == nil || v.SshUsername == nil {
+		var zero string
+		return zero
+	}
+	return *v.SshUsername
+}
+
+// GetStatus returns the Status field value if set, zero value otherwise.
+func (v *ApplicationsApiOverview) GetStatus() string {
+	if v == nil || v.Status == nil {
+		var zero string
+		return zero
+	}
+	return *v.Status
+}
+
+// GetTenant returns the Tenant field value if set, zero value otherwise.
+func (v *ApplicationsApiOverview) GetTenant() string {
+	if v == nil || v.Tenant == nil {
+		var zero string
+		return zero
+	}
+	return *v.Tenant
+}
+
+// GetTenantSharedStorage returns the TenantSharedStorage field value if set, zero value otherwise.
+func (v *ApplicationsApiOverview) GetTenantSharedStorage() bool {
+	if v == nil || v.TenantSharedStorage == nil {
+		var zero bool
+		return zero
+	}
+	return *v.TenantSharedStorage
+}
+
 // ApplicationsApiRuntimeLogsResponse defines model for ApplicationsApiRuntimeLogsResponse.
 type ApplicationsApiRuntimeLogsResponse struct {
 	// Cluster The cluster where the application is running
@@ -254,6 +949,33 @@ type ApplicationsApiRuntimeLogsResponse struct {
 	Logs *string `json:"logs"`
 }
 
+// GetCluster returns the Cluster field value if set, zero value otherwise.
+func (v *ApplicationsApiRuntimeLogsResponse) GetCluster() string {
+	if v == nil || v.Cluster == nil {
+		var zero string
+		return zero
+	}
+	return *v.Cluster
+}
+
+// GetId returns the Id field value if set, zero value otherwise.
+func (v *ApplicationsApiRuntimeLogsResponse) GetId() string {
+	if v == nil || v.Id == nil {
+		var zero string
+		return zero
+	}
+	return *v.Id
+}
+
+// GetLogs returns the Logs field value if set, zero value otherwise.
+func (v *ApplicationsApiRuntimeLogsResponse) GetLogs() string {
+	if v == nil || v.Logs == nil {
+		var zero string
+		return zero
+	}
+	return *v.Logs
+}
+
 // ImageRepositoryDto defines model for ImageRepositoryDto.
 type ImageRepositoryDto struct {
 	// Hostname The registry hostname for the container repository.
@@ -272,6 +994,33 @@ type ImageRepositoryDto struct {
 	Username *string `json:"username"`
 }
 
+// GetHostname returns the Hostname field value if set, zero value otherwise.
+func (v *ImageRepositoryDto) GetHostname() string {
+	if v == nil || v.Hostname == nil {
+		var zero string
+		return zero
+	}
+	return *v.Hostname
+}
+
+// GetPassword returns the Password field value if set, zero value otherwise.
+func (v *ImageRepositoryDto) GetPassword() string {
+	if v == nil || v.Password == nil {
+		var zero string
+		return zero
+	}
+	return *v.Password
+}
+
+// GetUsername returns the Username field value if set, zero value otherwise.
+func (v *ImageRepositoryDto) GetUsername() string {
+	if v == nil || v.Username == nil {
+		var zero string
+		return zero
+	}
+	return *v.Username
+}
+
 // InstanceDetails defines model for InstanceDetails.
 type InstanceDetails struct {
 	Cluster *string `json:"cluster"`
@@ -282,12 +1031,12 @@ type InstanceDetails struct {
 	// ContainerUid User ID (UID) for running container when not using root privileges
 	ContainerUid                   *int32              `json:"containerUid"`
 	CreatedBy                      *string             `json:"createdBy"`
-	CreationTime                   *string             `json:"creationTime,omitempty"`
+	CreationTime                   *models.Time        `json:"creationTime,omitempty"`
 	Dns                            *string             `json:"dns"`
 	EnvironmentVariables           *map[string]*string `json:"environmentVariables"`
 	Id                             *string             `json:"id"`
 	ImageCmdOverride               *string             `json:"imageCmdOverride"`
-	LastUpdated                    *string             `json:"lastUpdated"`
+	LastUpdated                    *models.Time        `json:"lastUpdated"`
 	NodeSelector                   *string             `json:"nodeSelector"`
 	PersistedDirectAttachedStorage *bool               `json:"persistedDirectAttachedStorage,omitempty"`
 	PersonalSharedStorage          *bool               `json:"personalSharedStorage,omitempty"`
@@ -306,26 +1055,234 @@ type InstanceDetails struct {
 	TenantSharedStorage *bool   `json:"tenantSharedStorage,omitempty"`
 }
 
-// ListResultDtoOfApplicationsApiApplicationConfig defines model for ListResultDtoOfApplicationsApiApplicationConfig.
-type ListResultDtoOfApplicationsApiApplicationConfig struct {
-	Items *[]ApplicationsApiApplicationConfig `json:"items"`
+// GetCluster returns the Cluster field value if set, zero value otherwise.
+func (v *InstanceDetails) GetCluster() string {
+	if v == nil || v.Cluster == nil {
+		var zero string
+		return zero
+	}
+	return *v.Cluster
 }
 
-// ListResultDtoOfApplicationsApiApplicationConfigAvailability defines model for ListResultDtoOfApplicationsApiApplicationConfigAvailability.
-type ListResultDtoOfApplicationsApiApplicationConfigAvailability struct {
-	Items *[]ApplicationsApiApplicationConfigAvailability `json:"items"`
+// GetContainerGid returns the ContainerGid field value if set, zero value otherwise.
+func (v *InstanceDetails) GetContainerGid() int32 {
+	if v == nil || v.ContainerGid == nil {
+		var zero int32
+		return zero
+	}
+	return *v.ContainerGid
 }
 
-// ListResultDtoOfApplicationsApiCatalogItem defines model for ListResultDtoOfApplicationsApiCatalogItem.
-type ListResultDtoOfApplicationsApiCatalogItem struct {
-	Items *[]ApplicationsApiCatalogItem `json:"items"`
+// GetContainerUid returns the ContainerUid field value if set, zero value otherwise.
+func (v *InstanceDetails) GetContainerUid() int32 {
+	if v == nil || v.ContainerUid == nil {
+		var zero int32
+		return zero
+	}
+	return *v.ContainerUid
 }
 
-// ListResultDtoOfApplicationsApiOverview defines model for ListResultDtoOfApplicationsApiOverview.
-type ListResultDtoOfApplicationsApiOverview struct {
-	Items *[]ApplicationsApiOverview `json:"items"`
+// GetCreatedBy returns the CreatedBy field value if set, zero value otherwise.
+func (v *InstanceDetails) GetCreatedBy() string {
+	if v == nil || v.CreatedBy == nil {
+		var zero string
+		return zero
+	}
+	return *v.CreatedBy
+}
+
+// GetCreationTime returns the CreationTime field value if set, zero value otherwise.
+func (v *InstanceDetails) GetCreationTime() models.Time {
+	if v == nil || v.CreationTime == nil {
+		var zero models.Time
+		return zero
+	}
+	return *v.CreationTime
+}
+
+// GetDns returns the Dns field value if set, zero value otherwise.
+func (v *InstanceDetails) GetDns() string {
+	if v == nil || v.Dns == nil {
+		var zero string
+		return zero
+	}
+	return *v.Dns
+}
+
+// GetEnvironmentVariables returns the EnvironmentVariables field value if set, zero value otherwise.
+func (v *InstanceDetails) GetEnvironmentVariables() map[string]*string {
+	if v == nil || v.EnvironmentVariables == nil {
+		var zero map[string]*string
+		return zero
+	}
+	return *v.EnvironmentVariables
+}
+
+// GetId returns the Id field value if set, zero value otherwise.
+func (v *InstanceDetails) GetId() string {
+	if v == nil || v.Id == nil {
+		var zero string
+		return zero
+	}
+	return *v.Id
+}
+
+// GetImageCmdOverride returns the ImageCmdOverride field value if set, zero value otherwise.
+func (v *InstanceDetails) GetImageCmdOverride() string {
+	if v == nil || v.ImageCmdOverride == nil {
+		var zero string
+		return zero
+	}
+	return *v.ImageCmdOverride
+}
+
+// GetLastUpdated returns the LastUpdated field value if set, zero value otherwise.
+func (v *InstanceDetails) GetLastUpdated() models.Time {
+	if v == nil || v.LastUpdated == nil {
+		var zero models.Time
+		return zero
+	}
+	return *v.LastUpdated
+}
+
+// GetNodeSelector returns the NodeSelector field value if set, zero value otherwise.
+func (v *InstanceDetails) GetNodeSelector() string {
+	if v == nil || v.NodeSelector == nil {
+		var zero string
+		return zero
+	}
+	return *v.NodeSelector
+}
+
+// GetPersistedDirectAttachedStorage returns the PersistedDirectAttachedStorage field value if set, zero value otherwise.
+func (v *InstanceDetails) GetPersistedDirectAttachedStorage() bool {
+	if v == nil || v.PersistedDirectAttachedStorage == nil {
+		var zero bool
+		return zero
+	}
+	return *v.PersistedDirectAttachedStorage
+}
+
+// GetPersonalSharedStorage returns the PersonalSharedStorage field value if set, zero value otherwise.
+func (v *InstanceDetails) GetPersonalSharedStorage() bool {
+	if v == nil || v.PersonalSharedStorage == nil {
+		var zero bool
+		return zero
+	}
+	return *v.PersonalSharedStorage
+}
+
+// GetPrivateIp returns the PrivateIp field value if set, zero value otherwise.
+func (v *InstanceDetails) GetPrivateIp() string {
+	if v == nil || v.PrivateIp == nil {
+		var zero string
+		return zero
+	}
+	return *v.PrivateIp
+}
+
+// GetProxyPort returns the ProxyPort field value if set, zero value otherwise.
+func (v *InstanceDetails) GetProxyPort() int32 {
+	if v == nil || v.ProxyPort == nil {
+		var zero int32
+		return zero
+	}
+	return *v.ProxyPort
+}
+
+// GetPublicIp returns the PublicIp field value if set, zero value otherwise.
+func (v *InstanceDetails) GetPublicIp() string {
+	if v == nil || v.PublicIp == nil {
+		var zero string
+		return zero
+	}
+	return *v.PublicIp
 }
 
+// GetReadinessWatcherPort returns the ReadinessWatcherPort field value if set, zero value otherwise.
+func (v *InstanceDetails) GetReadinessWatcherPort() int32 {
+	if v == nil || v.ReadinessWatcherPort == nil {
+		var zero int32
+		return zero
+	}
+	return *v.ReadinessWatcherPort
+}
+
+// GetResourcePool returns the ResourcePool field value if set, zero value otherwise.
+func (v *InstanceDetails) GetResourcePool() string {
+	if v == nil || v.ResourcePool == nil {
+		var zero string
+		return zero
+	}
+	return *v.ResourcePool
+}
+
+// GetRunAsRoot returns the RunAsRoot field value if set, zero value otherwise.
+func (v *InstanceDetails) GetRunAsRoot() bool {
+	if v == nil || v.RunAsRoot == nil {
+		var zero bool
+		return zero
+	}
+	return *v.RunAsRoot
+}
+
+// GetStatus returns the Status field value if set, zero value otherwise.
+func (v *InstanceDetails) GetStatus() string {
+	if v == nil || v.Status == nil {
+		var zero string
+		return zero
+	}
+	return *v.Status
+}
+
+// GetStatusMessage returns the StatusMessage field value if set, zero value otherwise.
+func (v *InstanceDetails) GetStatusMessage() string {
+	if v == nil || v.StatusMessage == nil {
+		var zero string
+		return zero
+	}
+	return *v.StatusMessage
+}
+
+// GetStatusReason returns the StatusReason field value if set, zero value otherwise.
+func (v *InstanceDetails) GetStatusReason() string {
+	if v == nil || v.StatusReason == nil {
+		var zero string
+		return zero
+	}
+	return *v.StatusReason
+}
+
+// GetTenant returns the Tenant field value if set, zero value otherwise.
+func (v *InstanceDetails) GetTenant() string {
+	if v == nil || v.Tenant == nil {
+		var zero string
+		return zero
+	}
+	return *v.Tenant
+}
+
+// GetTenantSharedStorage returns the TenantSharedStorage field value if set, zero value otherwise.
+func (v *InstanceDetails) GetTenantSharedStorage() bool {
+	if v == nil || v.TenantSharedStorage == nil {
+		var zero bool
+		return zero
+	}
+	return *v.TenantSharedStorage
+}
+
+// ListResultDtoOfApplicationsApiApplicationConfig defines model for ListResultDtoOfApplicationsApiApplicationConfig.
+type ListResultDtoOfApplicationsApiApplicationConfig = models.ListResult[ApplicationsApiApplicationConfig]
+
+// ListResultDtoOfApplicationsApiApplicationConfigAvailability defines model for ListResultDtoOfApplicationsApiApplicationConfigAvailability.
+type ListResultDtoOfApplicationsApiApplicationConfigAvailability = models.ListResult[ApplicationsApiApplicationConfigAvailability]
+
+// ListResultDtoOfApplicationsApiCatalogItem defines model for ListResultDtoOfApplicationsApiCatalogItem.
+type ListResultDtoOfApplicationsApiCatalogItem = models.ListResult[ApplicationsApiCatalogItem]
+
+// ListResultDtoOfApplicationsApiOverview defines model for ListResultDtoOfApplicationsApiOverview.
+type ListResultDtoOfApplicationsApiOverview = models.ListResult[ApplicationsApiOverview]
+
 // SecurityContextDto defines model for SecurityContextDto.
 type SecurityContextDto struct {
 	// ContainerGid Group ID (GID) for running container when not using root privileges
@@ -338,6 +1295,38 @@ type SecurityContextDto struct {
 	RunAsRoot *bool `json:"runAsRoot,omitempty"`
 }
 
+// GetContainerGid returns the ContainerGid field value if set, zero value otherwise.
+func (v *SecurityContextDto) GetContainerGid() int32 {
+	if v == nil || v.ContainerGid == nil {
+		var zero int32
+		return zero
+	}
+	return *v.ContainerGid
+}
+
+// GetContainerUid returns the ContainerUid field value if set, zero value otherwise.
+func (v *SecurityContextDto) GetContainerUid() int32 {
+	if v == nil || v.ContainerUid == nil {
+		var zero int32
+		return zero
+	}
+	return *v.ContainerUid
+}
+
+// GetRunAsRoot returns the RunAsRoot field value if set, zero value otherwise.
+func (v *SecurityContextDto) GetRunAsRoot() bool {
+	if v == nil || v.RunAsRoot == nil {
+		var zero bool
+		return zero
+	}
+	return *v.RunAsRoot
+}
+
+// Ptr returns a pointer to v, for setting the optional fields of the models (e.g., Ptr("my-vm")).
+func Ptr[T any](v T) *T {
+	return &v
+}
+
 // DestroyApplicationParams defines parameters for DestroyApplication.
 type DestroyApplicationParams struct {
 	// Id The application name
@@ -369,10 +1358,8 @@ type GetApplicationRuntimeLogsParams struct {
 }
 
 // GetAvailabilityParams defines parameters for GetAvailability.
-type GetAvailabilityParams struct {
-	Cluster      string `form:"cluster" json:"cluster"`
-	ResourcePool string `form:"resourcePool" json:"resourcePool"`
-}
+// The parameters are shared with the other services (see the models section of api/v1/services.yaml).
+type GetAvailabilityParams = models.AvailabilityParams
 
 // CreateCatalogApplicationApplicationWildcardPlusJSONRequestBody defines body for CreateCatalogApplication for application/*+json ContentType.
 type CreateCatalogApplicationApplicationWildcardPlusJSONRequestBody = ApplicationsApiCreateRequest
@@ -1565,4 +2552,76 @@ func (c *Client) applyEditors(ctx context.Context, req *http.Request, additional
 	return nil
 }
 
+// AllApplicationCatalogItems returns an iterator over the items returned by GetApplicationCatalogItems, which works with any ClientInterface
+// (e.g., mocks). The API returns every item in a single response, so the iterator makes a single request.
+func AllApplicationCatalogItems(ctx context.Context, c ClientInterface, reqEditors ...RequestEditorFn) iter.Seq2[ApplicationsApiCatalogItem, error] {
+	return func(yield func(ApplicationsApiCatalogItem, error) bool) {
+		rsp, err := c.GetApplicationCatalogItems(ctx, reqEditors...)
+		if err != nil {
+			var zero ApplicationsApiCatalogItem
+			yield(zero, err)
+			return
+		}
+		for _, item := range rsp.GetItems() {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// AllApplications returns an iterator over the items returned by GetApplications, which works with any ClientInterface
+// (e.g., mocks). The API returns every item in a single response, so the iterator makes a single request.
+func AllApplications(ctx context.Context, c ClientInterface, reqEditors ...RequestEditorFn) iter.Seq2[ApplicationsApiOverview, error] {
+	return func(yield func(ApplicationsApiOverview, error) bool) {
+		rsp, err := c.GetApplications(ctx, reqEditors...)
+		if err != nil {
+			var zero ApplicationsApiOverview
+			yield(zero, err)
+			return
+		}
+		for _, item := range rsp.GetItems() {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// AllAvailability returns an iterator over the items returned by GetAvailability, which works with any ClientInterface
+// (e.g., mocks). The API returns every item in a single response, so the iterator makes a single request.
+func AllAvailability(ctx context.Context, c ClientInterface, params *GetAvailabilityParams, reqEditors ...RequestEditorFn) iter.Seq2[ApplicationsApiApplicationConfigAvailability, error] {
+	return func(yield func(ApplicationsApiApplicationConfigAvailability, error) bool) {
+		rsp, err := c.GetAvailability(ctx, params, reqEditors...)
+		if err != nil {
+			var zero ApplicationsApiApplicationConfigAvailability
+			yield(zero, err)
+			return
+		}
+		for _, item := range rsp.GetItems() {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// AllConfigurations returns an iterator over the items returned by GetConfigurations, which works with any ClientInterface
+// (e.g., mocks). The API returns every item in a single response, so the iterator makes a single request.
+func AllConfigurations(ctx context.Context, c ClientInterface, reqEditors ...RequestEditorFn) iter.Seq2[ApplicationsApiApplicationConfig, error] {
+	return func(yield func(ApplicationsApiApplicationConfig, error) bool) {
+		rsp, err := c.GetConfigurations(ctx, reqEditors...)
+		if err != nil {
+			var zero ApplicationsApiApplicationConfig
+			yield(zero, err)
+			return
+		}
+		for _, item := range rsp.GetItems() {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 // Leaving client-with-responses file blank since we don't need it
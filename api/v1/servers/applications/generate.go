@@ -1,3 +1,4 @@
 package applications
 
 //go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen -config cfg.yaml ../../api.json
+//go:generate go run github.com/denvrdata/go-denvr/tools/mockgen -source applications.gen.go -output mock/mock.gen.go
@@ -0,0 +1,377 @@
+// Package manifest loads declarative application definitions from YAML or TOML files
+// and converts them into application create requests.
+package manifest
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"gopkg.in/yaml.v3"
+)
+
+type Format string
+
+const (
+	YAML Format = "yaml"
+	TOML Format = "toml"
+)
+
+// Manifest describes either a catalog application (Catalog is set) or a custom application (Image is set).
+type Manifest struct {
+	Name                 string            `yaml:"name" toml:"name"`
+	Cluster              string            `yaml:"cluster" toml:"cluster"`
+	HardwarePackage      string            `yaml:"hardwarePackage" toml:"hardwarePackage"`
+	ResourcePool         string            `yaml:"resourcePool" toml:"resourcePool"`
+	SelectedNode         string            `yaml:"selectedNode" toml:"selectedNode"`
+	Catalog              *Catalog          `yaml:"catalog" toml:"catalog"`
+	Image                *Image            `yaml:"image" toml:"image"`
+	Environment          map[string]string `yaml:"environment" toml:"environment"`
+	Secrets              map[string]string `yaml:"secrets" toml:"secrets"`
+	UserScripts          map[string]Script `yaml:"userScripts" toml:"userScripts"`
+	SshKeys              []string          `yaml:"sshKeys" toml:"sshKeys"`
+	StartupCommands      []string          `yaml:"startupCommands" toml:"startupCommands"`
+	JupyterToken         string            `yaml:"jupyterToken" toml:"jupyterToken"`
+	Proxy                *Proxy            `yaml:"proxy" toml:"proxy"`
+	ReadinessWatcherPort *int32            `yaml:"readinessWatcherPort" toml:"readinessWatcherPort"`
+	SecurityContext      *SecurityContext  `yaml:"securityContext" toml:"securityContext"`
+	Storage              Storage           `yaml:"storage" toml:"storage"`
+}
+
+// Catalog selects an application catalog item and version.
+type Catalog struct {
+	Name    string `yaml:"name" toml:"name"`
+	Version string `yaml:"version" toml:"version"`
+}
+
+// Image describes the container image of a custom application.
+type Image struct {
+	Url        string      `yaml:"url" toml:"url"`
+	Command    []string    `yaml:"command" toml:"command"`
+	Repository *Repository `yaml:"repository" toml:"repository"`
+}
+
+type Repository struct {
+	Hostname string `yaml:"hostname" toml:"hostname"`
+	Username string `yaml:"username" toml:"username"`
+	Password string `yaml:"password" toml:"password"`
+}
+
+// Script is a user script provided either inline or read from a file relative to the manifest.
+type Script struct {
+	Content string `yaml:"content" toml:"content"`
+	File    string `yaml:"file" toml:"file"`
+}
+
+type Proxy struct {
+	Port    int32    `yaml:"port" toml:"port"`
+	ApiKeys []string `yaml:"apiKeys" toml:"apiKeys"`
+}
+
+type SecurityContext struct {
+	RunAsRoot *bool  `yaml:"runAsRoot" toml:"runAsRoot"`
+	Uid       *int32 `yaml:"uid" toml:"uid"`
+	Gid       *int32 `yaml:"gid" toml:"gid"`
+}
+
+type Storage struct {
+	PersistDirectAttached *bool `yaml:"persistDirectAttached" toml:"persistDirectAttached"`
+	PersonalShared        *bool `yaml:"personalShared" toml:"personalShared"`
+	TenantShared          *bool `yaml:"tenantShared" toml:"tenantShared"`
+}
+
+// Load reads a manifest from disk, picking the format from the file extension.
+// Relative user script files are resolved against the manifest's directory.
+func Load(path string) (*Manifest, error) {
+	var format Format
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		format = YAML
+	case ".toml":
+		format = TOML
+	default:
+		return nil, fmt.Errorf("unsupported manifest extension for %s, expected .yaml, .yml or .toml", path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(data, format, filepath.Dir(path))
+}
+
+// Parse decodes a manifest and expands `${VAR}` and `${VAR:-default}` references from the environment
+// in its string values (see Manifest.interpolate), where `$${VAR}` is kept as a literal `${VAR}`.
+// Unknown fields are rejected and user script files are read relative to dir.
+func Parse(data []byte, format Format, dir string) (*Manifest, error) {
+	var m Manifest
+	switch format {
+	case YAML:
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(&m); err != nil {
+			return nil, fmt.Errorf("failed to decode manifest: %w", err)
+		}
+	case TOML:
+		md, err := toml.Decode(string(data), &m)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode manifest: %w", err)
+		}
+		if undecoded := md.Undecoded(); len(undecoded) > 0 {
+			keys := make([]string, len(undecoded))
+			for i, key := range undecoded {
+				keys[i] = key.String()
+			}
+			return nil, fmt.Errorf("failed to decode manifest: unknown fields %s", strings.Join(keys, ", "))
+		}
+	default:
+		return nil, fmt.Errorf("unsupported manifest format %q", format)
+	}
+
+	if err := m.interpolate(); err != nil {
+		return nil, err
+	}
+	if err := m.resolve(dir); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+var variablePattern = regexp.MustCompile(`\$(\$?)\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// interpolate expands environment references in the values describing the application.
+// Scripts, commands and secret sources are left alone, as they're run or looked up later
+// (e.g., `echo ${HOME}` in a user script refers to the application's environment).
+func (m *Manifest) interpolate() error {
+	var missing []string
+	expand := func(values ...*string) {
+		for _, s := range values {
+			*s = variablePattern.ReplaceAllStringFunc(
+				*s,
+				func(match string) string {
+					groups := variablePattern.FindStringSubmatch(match)
+					if groups[1] != "" {
+						return match[1:]
+					}
+					if value, ok := os.LookupEnv(groups[2]); ok {
+						return value
+					}
+					if groups[3] != "" {
+						return groups[4]
+					}
+					if !slices.Contains(missing, groups[2]) {
+						missing = append(missing, groups[2])
+					}
+					return match
+				},
+			)
+		}
+	}
+
+	expand(&m.Name, &m.Cluster, &m.HardwarePackage, &m.ResourcePool, &m.SelectedNode, &m.JupyterToken)
+	if m.Catalog != nil {
+		expand(&m.Catalog.Name, &m.Catalog.Version)
+	}
+	if m.Image != nil {
+		expand(&m.Image.Url)
+		if repo := m.Image.Repository; repo != nil {
+			expand(&repo.Hostname, &repo.Username, &repo.Password)
+		}
+	}
+	for k, v := range m.Environment {
+		expand(&v)
+		m.Environment[k] = v
+	}
+	for i := range m.SshKeys {
+		expand(&m.SshKeys[i])
+	}
+	if m.Proxy != nil {
+		for i := range m.Proxy.ApiKeys {
+			expand(&m.Proxy.ApiKeys[i])
+		}
+	}
+
+	if len(missing) > 0 {
+		slices.Sort(missing)
+		return fmt.Errorf("manifest references unset environment variables: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// resolve reads script files and checks the catalog/image selection.
+func (m *Manifest) resolve(dir string) error {
+	if (m.Catalog == nil) == (m.Image == nil) {
+		return fmt.Errorf("manifest %q must specify exactly one of catalog or image", m.Name)
+	}
+
+	for name, script := range m.UserScripts {
+		if script.File != "" && script.Content != "" {
+			return fmt.Errorf("userScripts[%q] must specify only one of content or file", name)
+		}
+		if script.File != "" {
+			path := script.File
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(dir, path)
+			}
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("userScripts[%q]: %w", name, err)
+			}
+			script.Content = string(content)
+			m.UserScripts[name] = script
+		}
+	}
+	return nil
+}
+
+// environment merges the plain environment variables with the secrets looked up from the local environment.
+func (m *Manifest) environment() (*map[string]*string, error) {
+	if len(m.Environment) == 0 && len(m.Secrets) == 0 {
+		return nil, nil
+	}
+
+	env := make(map[string]*string, len(m.Environment)+len(m.Secrets))
+	for k, v := range m.Environment {
+		env[k] = &v
+	}
+	for k, source := range m.Secrets {
+		if _, ok := env[k]; ok {
+			return nil, fmt.Errorf("secrets[%q] conflicts with an environment variable of the same name", k)
+		}
+		value, ok := os.LookupEnv(source)
+		if !ok {
+			return nil, fmt.Errorf("secrets[%q]: environment variable %s is not set", k, source)
+		}
+		env[k] = &value
+	}
+	return &env, nil
+}
+
+// CustomRequest builds a validated custom application create request from an image manifest.
+func (m *Manifest) CustomRequest() (applications.ApplicationsApiCustomApiCreateRequest, error) {
+	var req applications.ApplicationsApiCustomApiCreateRequest
+	if m.Image == nil {
+		return req, fmt.Errorf("manifest %q does not define an image", m.Name)
+	}
+	if len(m.SshKeys) > 0 || len(m.StartupCommands) > 0 || m.JupyterToken != "" {
+		return req, fmt.Errorf(
+			"manifest %q: sshKeys, startupCommands and jupyterToken are only supported for catalog applications",
+			m.Name,
+		)
+	}
+
+	env, err := m.environment()
+	if err != nil {
+		return req, err
+	}
+
+	req = applications.ApplicationsApiCustomApiCreateRequest{
+		Name:                         m.Name,
+		Cluster:                      m.Cluster,
+		HardwarePackageName:          m.HardwarePackage,
+		ImageUrl:                     m.Image.Url,
+		EnvironmentVariables:         env,
+		ResourcePool:                 optional(m.ResourcePool),
+		SelectedNode:                 optional(m.SelectedNode),
+		ReadinessWatcherPort:         m.ReadinessWatcherPort,
+		PersistDirectAttachedStorage: m.Storage.PersistDirectAttached,
+		PersonalSharedStorage:        m.Storage.PersonalShared,
+		TenantSharedStorage:          m.Storage.TenantShared,
+	}
+	if m.Image.Command != nil {
+		req.ImageCmdOverride = &m.Image.Command
+	}
+	if repo := m.Image.Repository; repo != nil {
+		req.ImageRepository = &applications.ImageRepositoryDto{
+			Hostname: optional(repo.Hostname),
+			Username: optional(repo.Username),
+			Password: optional(repo.Password),
+		}
+	}
+	if m.Proxy != nil {
+		req.ProxyPort = &m.Proxy.Port
+		if m.Proxy.ApiKeys != nil {
+			req.ProxyApiKeys = &m.Proxy.ApiKeys
+		}
+	}
+	if sc := m.SecurityContext; sc != nil {
+		req.SecurityContext = &applications.SecurityContextDto{
+			RunAsRoot:    sc.RunAsRoot,
+			ContainerUid: sc.Uid,
+			ContainerGid: sc.Gid,
+		}
+	}
+	if len(m.UserScripts) > 0 {
+		scripts := make(map[string]*string, len(m.UserScripts))
+		for name, script := range m.UserScripts {
+			scripts[name] = &script.Content
+		}
+		req.UserScripts = &scripts
+	}
+
+	return req, req.Validate()
+}
+
+// CatalogRequest builds a catalog application create request from a catalog manifest.
+func (m *Manifest) CatalogRequest() (applications.ApplicationsApiCreateRequest, error) {
+	var req applications.ApplicationsApiCreateRequest
+	if m.Catalog == nil {
+		return req, fmt.Errorf("manifest %q does not define a catalog item", m.Name)
+	}
+	if len(m.UserScripts) > 0 || m.SecurityContext != nil || m.ReadinessWatcherPort != nil {
+		return req, fmt.Errorf(
+			"manifest %q: userScripts, securityContext and readinessWatcherPort are only supported for custom applications",
+			m.Name,
+		)
+	}
+
+	env, err := m.environment()
+	if err != nil {
+		return req, err
+	}
+
+	req = applications.ApplicationsApiCreateRequest{
+		Name:                          m.Name,
+		Cluster:                       m.Cluster,
+		HardwarePackageName:           m.HardwarePackage,
+		ApplicationCatalogItemName:    m.Catalog.Name,
+		ApplicationCatalogItemVersion: m.Catalog.Version,
+		EnvironmentVariables:          env,
+		JupyterToken:                  optional(m.JupyterToken),
+		ResourcePool:                  optional(m.ResourcePool),
+		SelectedNode:                  optional(m.SelectedNode),
+		PersistDirectAttachedStorage:  m.Storage.PersistDirectAttached,
+		PersonalSharedStorage:         m.Storage.PersonalShared,
+		TenantSharedStorage:           m.Storage.TenantShared,
+	}
+	if m.SshKeys != nil {
+		req.SshKeys = &m.SshKeys
+	}
+	if m.StartupCommands != nil {
+		req.StartupCommands = &m.StartupCommands
+	}
+	if m.Proxy != nil {
+		port := fmt.Sprint(m.Proxy.Port)
+		req.ProxyPort = &port
+		if m.Proxy.ApiKeys != nil {
+			req.ProxyApiKeys = &m.Proxy.ApiKeys
+		}
+	}
+
+	return req, nil
+}
+
+// optional returns nil for empty strings so unset manifest values are omitted from requests.
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,154 @@
+package manifest_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications/manifest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "setup.sh"), []byte("#!/bin/bash\necho setup\n"), 0o600))
+
+	t.Setenv("APP_CLUSTER", "Hou1")
+	t.Setenv("HF_TOKEN_SOURCE", "hf_secret")
+
+	t.Run(
+		"CustomYAML",
+		func(t *testing.T) {
+			path := filepath.Join(dir, "app.yaml")
+			content := `
+name: trainer
+cluster: ${APP_CLUSTER}
+hardwarePackage: g-nvidia-1xa100-40gb-pcie-14vcpu-112gb
+resourcePool: ${APP_RPOOL:-on-demand}
+image:
+  url: ghcr.io/denvrdata/trainer:latest
+  command: ["python", "train.py"]
+environment:
+  HF_HOME: /cache
+secrets:
+  HF_TOKEN: HF_TOKEN_SOURCE
+userScripts:
+  setup.sh:
+    file: setup.sh
+proxy:
+  port: 8080
+  apiKeys: ["key-1"]
+securityContext:
+  runAsRoot: false
+  uid: 1000
+  gid: 1000
+`
+			assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+			m, err := manifest.Load(path)
+			assert.NoError(t, err)
+
+			req, err := m.CustomRequest()
+			assert.NoError(t, err)
+			assert.Equal(t, "trainer", req.Name)
+			assert.Equal(t, "Hou1", req.Cluster)
+			assert.Equal(t, "on-demand", *req.ResourcePool)
+			assert.Equal(t, []string{"python", "train.py"}, *req.ImageCmdOverride)
+			assert.Equal(t, "/cache", *(*req.EnvironmentVariables)["HF_HOME"])
+			assert.Equal(t, "hf_secret", *(*req.EnvironmentVariables)["HF_TOKEN"])
+			assert.Equal(t, "#!/bin/bash\necho setup\n", *(*req.UserScripts)["setup.sh"])
+			assert.Equal(t, int32(8080), *req.ProxyPort)
+			assert.Equal(t, int32(1000), *req.SecurityContext.ContainerUid)
+
+			_, err = m.CatalogRequest()
+			assert.ErrorContains(t, err, "does not define a catalog item")
+		},
+	)
+
+	t.Run(
+		"CatalogTOML",
+		func(t *testing.T) {
+			path := filepath.Join(dir, "app.toml")
+			content := `
+name = "notebook"
+cluster = "${APP_CLUSTER}"
+hardwarePackage = "g-nvidia-1xa100-40gb-pcie-14vcpu-112gb"
+jupyterToken = "abc123"
+sshKeys = ["ssh-ed25519 AAAA test"]
+
+[catalog]
+name = "jupyter-notebook"
+version = "python-3.11.9"
+
+[proxy]
+port = 8888
+`
+			assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+			m, err := manifest.Load(path)
+			assert.NoError(t, err)
+
+			req, err := m.CatalogRequest()
+			assert.NoError(t, err)
+			assert.Equal(t, "Hou1", req.Cluster)
+			assert.Equal(t, "jupyter-notebook", req.ApplicationCatalogItemName)
+			assert.Equal(t, "python-3.11.9", req.ApplicationCatalogItemVersion)
+			assert.Equal(t, "8888", *req.ProxyPort)
+			assert.Equal(t, "abc123", *req.JupyterToken)
+			assert.Nil(t, req.ResourcePool)
+		},
+	)
+
+	t.Run(
+		"Interpolation",
+		func(t *testing.T) {
+			t.Setenv("APP_PASSWORD", "p\"ss\nword: x")
+			content := `
+name: trainer
+image:
+  url: ghcr.io/denvrdata/trainer:latest
+  command: ["sh", "-c", "echo ${HOME}"]
+  repository:
+    password: ${APP_PASSWORD}
+environment:
+  LITERAL: $${APP_PASSWORD}
+  DEFAULT: ${DENVR_TEST_UNSET:-fallback}
+userScripts:
+  setup.sh:
+    content: echo ${HOME} ${DENVR_TEST_UNSET}
+`
+			m, err := manifest.Parse([]byte(content), manifest.YAML, dir)
+			assert.NoError(t, err)
+			assert.Equal(t, "p\"ss\nword: x", m.Image.Repository.Password, "values are inserted after decoding")
+			assert.Equal(t, "${APP_PASSWORD}", m.Environment["LITERAL"])
+			assert.Equal(t, "fallback", m.Environment["DEFAULT"])
+			assert.Equal(t, "echo ${HOME} ${DENVR_TEST_UNSET}", m.UserScripts["setup.sh"].Content)
+			assert.Equal(t, []string{"sh", "-c", "echo ${HOME}"}, m.Image.Command)
+		},
+	)
+
+	t.Run(
+		"Errors",
+		func(t *testing.T) {
+			_, err := manifest.Parse([]byte("name: x\nimage: {url: foo}\nbogus: 1\n"), manifest.YAML, dir)
+			assert.ErrorContains(t, err, "field bogus not found")
+
+			_, err = manifest.Parse([]byte("name = \"x\"\nbogus = 1\n[image]\nurl = \"foo\"\n"), manifest.TOML, dir)
+			assert.ErrorContains(t, err, "unknown fields bogus")
+
+			_, err = manifest.Parse([]byte("name: ${DENVR_TEST_UNSET}\nimage: {url: foo}\n"), manifest.YAML, dir)
+			assert.ErrorContains(t, err, "unset environment variables: DENVR_TEST_UNSET")
+
+			_, err = manifest.Parse([]byte("name: x\n"), manifest.YAML, dir)
+			assert.ErrorContains(t, err, "exactly one of catalog or image")
+
+			m, err := manifest.Parse([]byte("name: x\nimage: {url: foo}\nsecrets: {TOKEN: DENVR_TEST_UNSET}\n"), manifest.YAML, dir)
+			assert.NoError(t, err)
+			_, err = m.CustomRequest()
+			assert.ErrorContains(t, err, "environment variable DENVR_TEST_UNSET is not set")
+
+			_, err = manifest.Load(filepath.Join(dir, "app.json"))
+			assert.ErrorContains(t, err, "unsupported manifest extension")
+		},
+	)
+}
@@ -0,0 +1,1499 @@
+// Package mock provides a programmable fake of applications.ClientInterface for tests.
+//
+// Code generated by github.com/denvrdata/go-denvr/tools/mockgen DO NOT EDIT.
+package mock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+)
+
+// ErrNotStubbed is returned by calls without a stub func or a matching stub.
+var ErrNotStubbed = errors.New("mock: call not stubbed")
+
+// Call is a recorded call. Args excludes the context and request editors.
+type Call struct {
+	Method string
+	Args   []any
+}
+
+// Matches reports whether the arguments of the call satisfy the matchers, in order.
+// Arguments without a matcher are ignored.
+func (c Call) Matches(matchers ...Matcher) bool {
+	if len(matchers) > len(c.Args) {
+		return false
+	}
+	for i, m := range matchers {
+		if !m.Match(c.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Matcher matches a call argument.
+type Matcher interface {
+	Match(arg any) bool
+}
+
+// MatcherFunc adapts a function to a Matcher.
+type MatcherFunc func(arg any) bool
+
+func (f MatcherFunc) Match(arg any) bool {
+	return f(arg)
+}
+
+// Any matches every argument.
+func Any() Matcher {
+	return MatcherFunc(func(any) bool { return true })
+}
+
+// Eq matches arguments deeply equal to v. Pointers are compared by the values they point to.
+func Eq(v any) Matcher {
+	want := indirect(v)
+	return MatcherFunc(func(arg any) bool { return reflect.DeepEqual(indirect(arg), want) })
+}
+
+// Func matches arguments of type T for which f returns true.
+func Func[T any](f func(T) bool) Matcher {
+	return MatcherFunc(func(arg any) bool {
+		v, ok := arg.(T)
+		return ok && f(v)
+	})
+}
+
+func indirect(v any) any {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return nil
+	}
+	return rv.Interface()
+}
+
+// Stub returns a canned response from calls with matching arguments.
+type Stub[T any] struct {
+	matchers []Matcher
+	rsp      T
+	err      error
+	// times is the number of calls the stub answers, unlimited if zero
+	times int
+	used  int
+}
+
+// Return sets the response and error returned by the stub.
+func (s *Stub[T]) Return(rsp T, err error) *Stub[T] {
+	s.rsp, s.err = rsp, err
+	return s
+}
+
+// Times limits the number of calls answered by the stub, after which later stubs are considered.
+func (s *Stub[T]) Times(n int) *Stub[T] {
+	s.times = n
+	return s
+}
+
+func answer[T any](stubs []*Stub[T], args []any) (*Stub[T], bool) {
+	for _, s := range stubs {
+		if (s.times == 0 || s.used < s.times) && (Call{Args: args}).Matches(s.matchers...) {
+			s.used++
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+// Client is a programmable applications.ClientInterface.
+//
+// Each call is recorded, then answered by its stub func if set, otherwise by the first
+// matching stub registered with the corresponding On method, otherwise with ErrNotStubbed.
+type Client struct {
+	CreateCatalogApplicationWithBodyFunc                                func(ctx context.Context, contentType string, body io.Reader, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiOverview, error)
+	CreateCatalogApplicationWithBodyRawFunc                             func(ctx context.Context, contentType string, body io.Reader, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+	CreateCatalogApplicationWithApplicationWildcardPlusJSONBodyFunc     func(ctx context.Context, body applications.CreateCatalogApplicationApplicationWildcardPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiOverview, error)
+	CreateCatalogApplicationWithApplicationWildcardPlusJSONBodyRawFunc  func(ctx context.Context, body applications.CreateCatalogApplicationApplicationWildcardPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+	CreateCatalogApplicationFunc                                        func(ctx context.Context, body applications.CreateCatalogApplicationJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiOverview, error)
+	CreateCatalogApplicationRawFunc                                     func(ctx context.Context, body applications.CreateCatalogApplicationJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+	CreateCatalogApplicationWithApplicationJSONPatchPlusJSONBodyFunc    func(ctx context.Context, body applications.CreateCatalogApplicationApplicationJSONPatchPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiOverview, error)
+	CreateCatalogApplicationWithApplicationJSONPatchPlusJSONBodyRawFunc func(ctx context.Context, body applications.CreateCatalogApplicationApplicationJSONPatchPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+	CreateCustomApplicationWithBodyFunc                                 func(ctx context.Context, contentType string, body io.Reader, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiOverview, error)
+	CreateCustomApplicationWithBodyRawFunc                              func(ctx context.Context, contentType string, body io.Reader, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+	CreateCustomApplicationWithApplicationWildcardPlusJSONBodyFunc      func(ctx context.Context, body applications.CreateCustomApplicationApplicationWildcardPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiOverview, error)
+	CreateCustomApplicationWithApplicationWildcardPlusJSONBodyRawFunc   func(ctx context.Context, body applications.CreateCustomApplicationApplicationWildcardPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+	CreateCustomApplicationFunc                                         func(ctx context.Context, body applications.CreateCustomApplicationJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiOverview, error)
+	CreateCustomApplicationRawFunc                                      func(ctx context.Context, body applications.CreateCustomApplicationJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+	CreateCustomApplicationWithApplicationJSONPatchPlusJSONBodyFunc     func(ctx context.Context, body applications.CreateCustomApplicationApplicationJSONPatchPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiOverview, error)
+	CreateCustomApplicationWithApplicationJSONPatchPlusJSONBodyRawFunc  func(ctx context.Context, body applications.CreateCustomApplicationApplicationJSONPatchPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+	DestroyApplicationFunc                                              func(ctx context.Context, params *applications.DestroyApplicationParams, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiCommandResponse, error)
+	DestroyApplicationRawFunc                                           func(ctx context.Context, params *applications.DestroyApplicationParams, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+	GetApplicationCatalogItemsFunc                                      func(ctx context.Context, reqEditors ...applications.RequestEditorFn) (*applications.ListResultDtoOfApplicationsApiCatalogItem, error)
+	GetApplicationCatalogItemsRawFunc                                   func(ctx context.Context, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+	GetApplicationDetailsFunc                                           func(ctx context.Context, params *applications.GetApplicationDetailsParams, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiDetails, error)
+	GetApplicationDetailsRawFunc                                        func(ctx context.Context, params *applications.GetApplicationDetailsParams, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+	GetApplicationRuntimeLogsFunc                                       func(ctx context.Context, params *applications.GetApplicationRuntimeLogsParams, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiRuntimeLogsResponse, error)
+	GetApplicationRuntimeLogsRawFunc                                    func(ctx context.Context, params *applications.GetApplicationRuntimeLogsParams, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+	GetApplicationsFunc                                                 func(ctx context.Context, reqEditors ...applications.RequestEditorFn) (*applications.ListResultDtoOfApplicationsApiOverview, error)
+	GetApplicationsRawFunc                                              func(ctx context.Context, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+	GetAvailabilityFunc                                                 func(ctx context.Context, params *applications.GetAvailabilityParams, reqEditors ...applications.RequestEditorFn) (*applications.ListResultDtoOfApplicationsApiApplicationConfigAvailability, error)
+	GetAvailabilityRawFunc                                              func(ctx context.Context, params *applications.GetAvailabilityParams, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+	GetConfigurationsFunc                                               func(ctx context.Context, reqEditors ...applications.RequestEditorFn) (*applications.ListResultDtoOfApplicationsApiApplicationConfig, error)
+	GetConfigurationsRawFunc                                            func(ctx context.Context, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+	StartApplicationWithBodyFunc                                        func(ctx context.Context, contentType string, body io.Reader, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiCommandResponse, error)
+	StartApplicationWithBodyRawFunc                                     func(ctx context.Context, contentType string, body io.Reader, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+	StartApplicationWithApplicationWildcardPlusJSONBodyFunc             func(ctx context.Context, body applications.StartApplicationApplicationWildcardPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiCommandResponse, error)
+	StartApplicationWithApplicationWildcardPlusJSONBodyRawFunc          func(ctx context.Context, body applications.StartApplicationApplicationWildcardPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+	StartApplicationFunc                                                func(ctx context.Context, body applications.StartApplicationJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiCommandResponse, error)
+	StartApplicationRawFunc                                             func(ctx context.Context, body applications.StartApplicationJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+	StartApplicationWithApplicationJSONPatchPlusJSONBodyFunc            func(ctx context.Context, body applications.StartApplicationApplicationJSONPatchPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiCommandResponse, error)
+	StartApplicationWithApplicationJSONPatchPlusJSONBodyRawFunc         func(ctx context.Context, body applications.StartApplicationApplicationJSONPatchPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+	StopApplicationWithBodyFunc                                         func(ctx context.Context, contentType string, body io.Reader, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiCommandResponse, error)
+	StopApplicationWithBodyRawFunc                                      func(ctx context.Context, contentType string, body io.Reader, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+	StopApplicationWithApplicationWildcardPlusJSONBodyFunc              func(ctx context.Context, body applications.StopApplicationApplicationWildcardPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiCommandResponse, error)
+	StopApplicationWithApplicationWildcardPlusJSONBodyRawFunc           func(ctx context.Context, body applications.StopApplicationApplicationWildcardPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+	StopApplicationFunc                                                 func(ctx context.Context, body applications.StopApplicationJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiCommandResponse, error)
+	StopApplicationRawFunc                                              func(ctx context.Context, body applications.StopApplicationJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+	StopApplicationWithApplicationJSONPatchPlusJSONBodyFunc             func(ctx context.Context, body applications.StopApplicationApplicationJSONPatchPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiCommandResponse, error)
+	StopApplicationWithApplicationJSONPatchPlusJSONBodyRawFunc          func(ctx context.Context, body applications.StopApplicationApplicationJSONPatchPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error)
+
+	mu                                                                   sync.Mutex
+	calls                                                                []Call
+	stubsCreateCatalogApplicationWithBody                                []*Stub[*applications.ApplicationsApiOverview]
+	stubsCreateCatalogApplicationWithBodyRaw                             []*Stub[*http.Response]
+	stubsCreateCatalogApplicationWithApplicationWildcardPlusJSONBody     []*Stub[*applications.ApplicationsApiOverview]
+	stubsCreateCatalogApplicationWithApplicationWildcardPlusJSONBodyRaw  []*Stub[*http.Response]
+	stubsCreateCatalogApplication                                        []*Stub[*applications.ApplicationsApiOverview]
+	stubsCreateCatalogApplicationRaw                                     []*Stub[*http.Response]
+	stubsCreateCatalogApplicationWithApplicationJSONPatchPlusJSONBody    []*Stub[*applications.ApplicationsApiOverview]
+	stubsCreateCatalogApplicationWithApplicationJSONPatchPlusJSONBodyRaw []*Stub[*http.Response]
+	stubsCreateCustomApplicationWithBody                                 []*Stub[*applications.ApplicationsApiOverview]
+	stubsCreateCustomApplicationWithBodyRaw                              []*Stub[*http.Response]
+	stubsCreateCustomApplicationWithApplicationWildcardPlusJSONBody      []*Stub[*applications.ApplicationsApiOverview]
+	stubsCreateCustomApplicationWithApplicationWildcardPlusJSONBodyRaw   []*Stub[*http.Response]
+	stubsCreateCustomApplication                                         []*Stub[*applications.ApplicationsApiOverview]
+	stubsCreateCustomApplicationRaw                                      []*Stub[*http.Response]
+	stubsCreateCustomApplicationWithApplicationJSONPatchPlusJSONBody     []*Stub[*applications.ApplicationsApiOverview]
+	stubsCreateCustomApplicationWithApplicationJSONPatchPlusJSONBodyRaw  []*Stub[*http.Response]
+	stubsDestroyApplication                                              []*Stub[*applications.ApplicationsApiCommandResponse]
+	stubsDestroyApplicationRaw                                           []*Stub[*http.Response]
+	stubsGetApplicationCatalogItems                                      []*Stub[*applications.ListResultDtoOfApplicationsApiCatalogItem]
+	stubsGetApplicationCatalogItemsRaw                                   []*Stub[*http.Response]
+	stubsGetApplicationDetails                                           []*Stub[*applications.ApplicationsApiDetails]
+	stubsGetApplicationDetailsRaw                                        []*Stub[*http.Response]
+	stubsGetApplicationRuntimeLogs                                       []*Stub[*applications.ApplicationsApiRuntimeLogsResponse]
+	stubsGetApplicationRuntimeLogsRaw                                    []*Stub[*http.Response]
+	stubsGetApplications                                                 []*Stub[*applications.ListResultDtoOfApplicationsApiOverview]
+	stubsGetApplicationsRaw                                              []*Stub[*http.Response]
+	stubsGetAvailability                                                 []*Stub[*applications.ListResultDtoOfApplicationsApiApplicationConfigAvailability]
+	stubsGetAvailabilityRaw                                              []*Stub[*http.Response]
+	stubsGetConfigurations                                               []*Stub[*applications.ListResultDtoOfApplicationsApiApplicationConfig]
+	stubsGetConfigurationsRaw                                            []*Stub[*http.Response]
+	stubsStartApplicationWithBody                                        []*Stub[*applications.ApplicationsApiCommandResponse]
+	stubsStartApplicationWithBodyRaw                                     []*Stub[*http.Response]
+	stubsStartApplicationWithApplicationWildcardPlusJSONBody             []*Stub[*applications.ApplicationsApiCommandResponse]
+	stubsStartApplicationWithApplicationWildcardPlusJSONBodyRaw          []*Stub[*http.Response]
+	stubsStartApplication                                                []*Stub[*applications.ApplicationsApiCommandResponse]
+	stubsStartApplicationRaw                                             []*Stub[*http.Response]
+	stubsStartApplicationWithApplicationJSONPatchPlusJSONBody            []*Stub[*applications.ApplicationsApiCommandResponse]
+	stubsStartApplicationWithApplicationJSONPatchPlusJSONBodyRaw         []*Stub[*http.Response]
+	stubsStopApplicationWithBody                                         []*Stub[*applications.ApplicationsApiCommandResponse]
+	stubsStopApplicationWithBodyRaw                                      []*Stub[*http.Response]
+	stubsStopApplicationWithApplicationWildcardPlusJSONBody              []*Stub[*applications.ApplicationsApiCommandResponse]
+	stubsStopApplicationWithApplicationWildcardPlusJSONBodyRaw           []*Stub[*http.Response]
+	stubsStopApplication                                                 []*Stub[*applications.ApplicationsApiCommandResponse]
+	stubsStopApplicationRaw                                              []*Stub[*http.Response]
+	stubsStopApplicationWithApplicationJSONPatchPlusJSONBody             []*Stub[*applications.ApplicationsApiCommandResponse]
+	stubsStopApplicationWithApplicationJSONPatchPlusJSONBodyRaw          []*Stub[*http.Response]
+}
+
+var _ applications.ClientInterface = (*Client)(nil)
+
+// Calls returns the recorded calls to the method, or every call if method is empty.
+func (c *Client) Calls(method string) []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if method == "" {
+		return slices.Clone(c.calls)
+	}
+	var calls []Call
+	for _, call := range c.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Called reports whether the method was called with arguments satisfying the matchers.
+func (c *Client) Called(method string, matchers ...Matcher) bool {
+	for _, call := range c.Calls(method) {
+		if call.Matches(matchers...) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reset clears the recorded calls and registered stubs. Stub funcs are kept.
+func (c *Client) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = nil
+	c.stubsCreateCatalogApplicationWithBody = nil
+	c.stubsCreateCatalogApplicationWithBodyRaw = nil
+	c.stubsCreateCatalogApplicationWithApplicationWildcardPlusJSONBody = nil
+	c.stubsCreateCatalogApplicationWithApplicationWildcardPlusJSONBodyRaw = nil
+	c.stubsCreateCatalogApplication = nil
+	c.stubsCreateCatalogApplicationRaw = nil
+	c.stubsCreateCatalogApplicationWithApplicationJSONPatchPlusJSONBody = nil
+	c.stubsCreateCatalogApplicationWithApplicationJSONPatchPlusJSONBodyRaw = nil
+	c.stubsCreateCustomApplicationWithBody = nil
+	c.stubsCreateCustomApplicationWithBodyRaw = nil
+	c.stubsCreateCustomApplicationWithApplicationWildcardPlusJSONBody = nil
+	c.stubsCreateCustomApplicationWithApplicationWildcardPlusJSONBodyRaw = nil
+	c.stubsCreateCustomApplication = nil
+	c.stubsCreateCustomApplicationRaw = nil
+	c.stubsCreateCustomApplicationWithApplicationJSONPatchPlusJSONBody = nil
+	c.stubsCreateCustomApplicationWithApplicationJSONPatchPlusJSONBodyRaw = nil
+	c.stubsDestroyApplication = nil
+	c.stubsDestroyApplicationRaw = nil
+	c.stubsGetApplicationCatalogItems = nil
+	c.stubsGetApplicationCatalogItemsRaw = nil
+	c.stubsGetApplicationDetails = nil
+	c.stubsGetApplicationDetailsRaw = nil
+	c.stubsGetApplicationRuntimeLogs = nil
+	c.stubsGetApplicationRuntimeLogsRaw = nil
+	c.stubsGetApplications = nil
+	c.stubsGetApplicationsRaw = nil
+	c.stubsGetAvailability = nil
+	c.stubsGetAvailabilityRaw = nil
+	c.stubsGetConfigurations = nil
+	c.stubsGetConfigurationsRaw = nil
+	c.stubsStartApplicationWithBody = nil
+	c.stubsStartApplicationWithBodyRaw = nil
+	c.stubsStartApplicationWithApplicationWildcardPlusJSONBody = nil
+	c.stubsStartApplicationWithApplicationWildcardPlusJSONBodyRaw = nil
+	c.stubsStartApplication = nil
+	c.stubsStartApplicationRaw = nil
+	c.stubsStartApplicationWithApplicationJSONPatchPlusJSONBody = nil
+	c.stubsStartApplicationWithApplicationJSONPatchPlusJSONBodyRaw = nil
+	c.stubsStopApplicationWithBody = nil
+	c.stubsStopApplicationWithBodyRaw = nil
+	c.stubsStopApplicationWithApplicationWildcardPlusJSONBody = nil
+	c.stubsStopApplicationWithApplicationWildcardPlusJSONBodyRaw = nil
+	c.stubsStopApplication = nil
+	c.stubsStopApplicationRaw = nil
+	c.stubsStopApplicationWithApplicationJSONPatchPlusJSONBody = nil
+	c.stubsStopApplicationWithApplicationJSONPatchPlusJSONBodyRaw = nil
+}
+
+func (c *Client) record(method string, args ...any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = append(c.calls, Call{Method: method, Args: args})
+}
+
+// OnCreateCatalogApplicationWithBody registers a stub for CreateCatalogApplicationWithBody calls with arguments satisfying the matchers.
+func (c *Client) OnCreateCatalogApplicationWithBody(matchers ...Matcher) *Stub[*applications.ApplicationsApiOverview] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ApplicationsApiOverview]{matchers: matchers}
+	c.stubsCreateCatalogApplicationWithBody = append(c.stubsCreateCatalogApplicationWithBody, s)
+	return s
+}
+
+// CreateCatalogApplicationWithBody records the call and returns the stubbed response.
+func (c *Client) CreateCatalogApplicationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiOverview, error) {
+	args := []any{contentType, body}
+	c.record("CreateCatalogApplicationWithBody", args...)
+	if c.CreateCatalogApplicationWithBodyFunc != nil {
+		return c.CreateCatalogApplicationWithBodyFunc(ctx, contentType, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateCatalogApplicationWithBody, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ApplicationsApiOverview
+		return zero, fmt.Errorf("%w: CreateCatalogApplicationWithBody", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnCreateCatalogApplicationWithBodyRaw registers a stub for CreateCatalogApplicationWithBodyRaw calls with arguments satisfying the matchers.
+func (c *Client) OnCreateCatalogApplicationWithBodyRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsCreateCatalogApplicationWithBodyRaw = append(c.stubsCreateCatalogApplicationWithBodyRaw, s)
+	return s
+}
+
+// CreateCatalogApplicationWithBodyRaw records the call and returns the stubbed response.
+func (c *Client) CreateCatalogApplicationWithBodyRaw(ctx context.Context, contentType string, body io.Reader, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{contentType, body}
+	c.record("CreateCatalogApplicationWithBodyRaw", args...)
+	if c.CreateCatalogApplicationWithBodyRawFunc != nil {
+		return c.CreateCatalogApplicationWithBodyRawFunc(ctx, contentType, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateCatalogApplicationWithBodyRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: CreateCatalogApplicationWithBodyRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnCreateCatalogApplicationWithApplicationWildcardPlusJSONBody registers a stub for CreateCatalogApplicationWithApplicationWildcardPlusJSONBody calls with arguments satisfying the matchers.
+func (c *Client) OnCreateCatalogApplicationWithApplicationWildcardPlusJSONBody(matchers ...Matcher) *Stub[*applications.ApplicationsApiOverview] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ApplicationsApiOverview]{matchers: matchers}
+	c.stubsCreateCatalogApplicationWithApplicationWildcardPlusJSONBody = append(c.stubsCreateCatalogApplicationWithApplicationWildcardPlusJSONBody, s)
+	return s
+}
+
+// CreateCatalogApplicationWithApplicationWildcardPlusJSONBody records the call and returns the stubbed response.
+func (c *Client) CreateCatalogApplicationWithApplicationWildcardPlusJSONBody(ctx context.Context, body applications.CreateCatalogApplicationApplicationWildcardPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiOverview, error) {
+	args := []any{body}
+	c.record("CreateCatalogApplicationWithApplicationWildcardPlusJSONBody", args...)
+	if c.CreateCatalogApplicationWithApplicationWildcardPlusJSONBodyFunc != nil {
+		return c.CreateCatalogApplicationWithApplicationWildcardPlusJSONBodyFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateCatalogApplicationWithApplicationWildcardPlusJSONBody, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ApplicationsApiOverview
+		return zero, fmt.Errorf("%w: CreateCatalogApplicationWithApplicationWildcardPlusJSONBody", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnCreateCatalogApplicationWithApplicationWildcardPlusJSONBodyRaw registers a stub for CreateCatalogApplicationWithApplicationWildcardPlusJSONBodyRaw calls with arguments satisfying the matchers.
+func (c *Client) OnCreateCatalogApplicationWithApplicationWildcardPlusJSONBodyRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsCreateCatalogApplicationWithApplicationWildcardPlusJSONBodyRaw = append(c.stubsCreateCatalogApplicationWithApplicationWildcardPlusJSONBodyRaw, s)
+	return s
+}
+
+// CreateCatalogApplicationWithApplicationWildcardPlusJSONBodyRaw records the call and returns the stubbed response.
+func (c *Client) CreateCatalogApplicationWithApplicationWildcardPlusJSONBodyRaw(ctx context.Context, body applications.CreateCatalogApplicationApplicationWildcardPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{body}
+	c.record("CreateCatalogApplicationWithApplicationWildcardPlusJSONBodyRaw", args...)
+	if c.CreateCatalogApplicationWithApplicationWildcardPlusJSONBodyRawFunc != nil {
+		return c.CreateCatalogApplicationWithApplicationWildcardPlusJSONBodyRawFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateCatalogApplicationWithApplicationWildcardPlusJSONBodyRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: CreateCatalogApplicationWithApplicationWildcardPlusJSONBodyRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnCreateCatalogApplication registers a stub for CreateCatalogApplication calls with arguments satisfying the matchers.
+func (c *Client) OnCreateCatalogApplication(matchers ...Matcher) *Stub[*applications.ApplicationsApiOverview] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ApplicationsApiOverview]{matchers: matchers}
+	c.stubsCreateCatalogApplication = append(c.stubsCreateCatalogApplication, s)
+	return s
+}
+
+// CreateCatalogApplication records the call and returns the stubbed response.
+func (c *Client) CreateCatalogApplication(ctx context.Context, body applications.CreateCatalogApplicationJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiOverview, error) {
+	args := []any{body}
+	c.record("CreateCatalogApplication", args...)
+	if c.CreateCatalogApplicationFunc != nil {
+		return c.CreateCatalogApplicationFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateCatalogApplication, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ApplicationsApiOverview
+		return zero, fmt.Errorf("%w: CreateCatalogApplication", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnCreateCatalogApplicationRaw registers a stub for CreateCatalogApplicationRaw calls with arguments satisfying the matchers.
+func (c *Client) OnCreateCatalogApplicationRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsCreateCatalogApplicationRaw = append(c.stubsCreateCatalogApplicationRaw, s)
+	return s
+}
+
+// CreateCatalogApplicationRaw records the call and returns the stubbed response.
+func (c *Client) CreateCatalogApplicationRaw(ctx context.Context, body applications.CreateCatalogApplicationJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{body}
+	c.record("CreateCatalogApplicationRaw", args...)
+	if c.CreateCatalogApplicationRawFunc != nil {
+		return c.CreateCatalogApplicationRawFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateCatalogApplicationRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: CreateCatalogApplicationRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnCreateCatalogApplicationWithApplicationJSONPatchPlusJSONBody registers a stub for CreateCatalogApplicationWithApplicationJSONPatchPlusJSONBody calls with arguments satisfying the matchers.
+func (c *Client) OnCreateCatalogApplicationWithApplicationJSONPatchPlusJSONBody(matchers ...Matcher) *Stub[*applications.ApplicationsApiOverview] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ApplicationsApiOverview]{matchers: matchers}
+	c.stubsCreateCatalogApplicationWithApplicationJSONPatchPlusJSONBody = append(c.stubsCreateCatalogApplicationWithApplicationJSONPatchPlusJSONBody, s)
+	return s
+}
+
+// CreateCatalogApplicationWithApplicationJSONPatchPlusJSONBody records the call and returns the stubbed response.
+func (c *Client) CreateCatalogApplicationWithApplicationJSONPatchPlusJSONBody(ctx context.Context, body applications.CreateCatalogApplicationApplicationJSONPatchPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiOverview, error) {
+	args := []any{body}
+	c.record("CreateCatalogApplicationWithApplicationJSONPatchPlusJSONBody", args...)
+	if c.CreateCatalogApplicationWithApplicationJSONPatchPlusJSONBodyFunc != nil {
+		return c.CreateCatalogApplicationWithApplicationJSONPatchPlusJSONBodyFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateCatalogApplicationWithApplicationJSONPatchPlusJSONBody, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ApplicationsApiOverview
+		return zero, fmt.Errorf("%w: CreateCatalogApplicationWithApplicationJSONPatchPlusJSONBody", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnCreateCatalogApplicationWithApplicationJSONPatchPlusJSONBodyRaw registers a stub for CreateCatalogApplicationWithApplicationJSONPatchPlusJSONBodyRaw calls with arguments satisfying the matchers.
+func (c *Client) OnCreateCatalogApplicationWithApplicationJSONPatchPlusJSONBodyRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsCreateCatalogApplicationWithApplicationJSONPatchPlusJSONBodyRaw = append(c.stubsCreateCatalogApplicationWithApplicationJSONPatchPlusJSONBodyRaw, s)
+	return s
+}
+
+// CreateCatalogApplicationWithApplicationJSONPatchPlusJSONBodyRaw records the call and returns the stubbed response.
+func (c *Client) CreateCatalogApplicationWithApplicationJSONPatchPlusJSONBodyRaw(ctx context.Context, body applications.CreateCatalogApplicationApplicationJSONPatchPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{body}
+	c.record("CreateCatalogApplicationWithApplicationJSONPatchPlusJSONBodyRaw", args...)
+	if c.CreateCatalogApplicationWithApplicationJSONPatchPlusJSONBodyRawFunc != nil {
+		return c.CreateCatalogApplicationWithApplicationJSONPatchPlusJSONBodyRawFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateCatalogApplicationWithApplicationJSONPatchPlusJSONBodyRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: CreateCatalogApplicationWithApplicationJSONPatchPlusJSONBodyRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnCreateCustomApplicationWithBody registers a stub for CreateCustomApplicationWithBody calls with arguments satisfying the matchers.
+func (c *Client) OnCreateCustomApplicationWithBody(matchers ...Matcher) *Stub[*applications.ApplicationsApiOverview] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ApplicationsApiOverview]{matchers: matchers}
+	c.stubsCreateCustomApplicationWithBody = append(c.stubsCreateCustomApplicationWithBody, s)
+	return s
+}
+
+// CreateCustomApplicationWithBody records the call and returns the stubbed response.
+func (c *Client) CreateCustomApplicationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiOverview, error) {
+	args := []any{contentType, body}
+	c.record("CreateCustomApplicationWithBody", args...)
+	if c.CreateCustomApplicationWithBodyFunc != nil {
+		return c.CreateCustomApplicationWithBodyFunc(ctx, contentType, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateCustomApplicationWithBody, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ApplicationsApiOverview
+		return zero, fmt.Errorf("%w: CreateCustomApplicationWithBody", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnCreateCustomApplicationWithBodyRaw registers a stub for CreateCustomApplicationWithBodyRaw calls with arguments satisfying the matchers.
+func (c *Client) OnCreateCustomApplicationWithBodyRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsCreateCustomApplicationWithBodyRaw = append(c.stubsCreateCustomApplicationWithBodyRaw, s)
+	return s
+}
+
+// CreateCustomApplicationWithBodyRaw records the call and returns the stubbed response.
+func (c *Client) CreateCustomApplicationWithBodyRaw(ctx context.Context, contentType string, body io.Reader, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{contentType, body}
+	c.record("CreateCustomApplicationWithBodyRaw", args...)
+	if c.CreateCustomApplicationWithBodyRawFunc != nil {
+		return c.CreateCustomApplicationWithBodyRawFunc(ctx, contentType, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateCustomApplicationWithBodyRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: CreateCustomApplicationWithBodyRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnCreateCustomApplicationWithApplicationWildcardPlusJSONBody registers a stub for CreateCustomApplicationWithApplicationWildcardPlusJSONBody calls with arguments satisfying the matchers.
+func (c *Client) OnCreateCustomApplicationWithApplicationWildcardPlusJSONBody(matchers ...Matcher) *Stub[*applications.ApplicationsApiOverview] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ApplicationsApiOverview]{matchers: matchers}
+	c.stubsCreateCustomApplicationWithApplicationWildcardPlusJSONBody = append(c.stubsCreateCustomApplicationWithApplicationWildcardPlusJSONBody, s)
+	return s
+}
+
+// CreateCustomApplicationWithApplicationWildcardPlusJSONBody records the call and returns the stubbed response.
+func (c *Client) CreateCustomApplicationWithApplicationWildcardPlusJSONBody(ctx context.Context, body applications.CreateCustomApplicationApplicationWildcardPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiOverview, error) {
+	args := []any{body}
+	c.record("CreateCustomApplicationWithApplicationWildcardPlusJSONBody", args...)
+	if c.CreateCustomApplicationWithApplicationWildcardPlusJSONBodyFunc != nil {
+		return c.CreateCustomApplicationWithApplicationWildcardPlusJSONBodyFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateCustomApplicationWithApplicationWildcardPlusJSONBody, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ApplicationsApiOverview
+		return zero, fmt.Errorf("%w: CreateCustomApplicationWithApplicationWildcardPlusJSONBody", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnCreateCustomApplicationWithApplicationWildcardPlusJSONBodyRaw registers a stub for CreateCustomApplicationWithApplicationWildcardPlusJSONBodyRaw calls with arguments satisfying the matchers.
+func (c *Client) OnCreateCustomApplicationWithApplicationWildcardPlusJSONBodyRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsCreateCustomApplicationWithApplicationWildcardPlusJSONBodyRaw = append(c.stubsCreateCustomApplicationWithApplicationWildcardPlusJSONBodyRaw, s)
+	return s
+}
+
+// CreateCustomApplicationWithApplicationWildcardPlusJSONBodyRaw records the call and returns the stubbed response.
+func (c *Client) CreateCustomApplicationWithApplicationWildcardPlusJSONBodyRaw(ctx context.Context, body applications.CreateCustomApplicationApplicationWildcardPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{body}
+	c.record("CreateCustomApplicationWithApplicationWildcardPlusJSONBodyRaw", args...)
+	if c.CreateCustomApplicationWithApplicationWildcardPlusJSONBodyRawFunc != nil {
+		return c.CreateCustomApplicationWithApplicationWildcardPlusJSONBodyRawFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateCustomApplicationWithApplicationWildcardPlusJSONBodyRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: CreateCustomApplicationWithApplicationWildcardPlusJSONBodyRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnCreateCustomApplication registers a stub for CreateCustomApplication calls with arguments satisfying the matchers.
+func (c *Client) OnCreateCustomApplication(matchers ...Matcher) *Stub[*applications.ApplicationsApiOverview] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ApplicationsApiOverview]{matchers: matchers}
+	c.stubsCreateCustomApplication = append(c.stubsCreateCustomApplication, s)
+	return s
+}
+
+// CreateCustomApplication records the call and returns the stubbed response.
+func (c *Client) CreateCustomApplication(ctx context.Context, body applications.CreateCustomApplicationJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiOverview, error) {
+	args := []any{body}
+	c.record("CreateCustomApplication", args...)
+	if c.CreateCustomApplicationFunc != nil {
+		return c.CreateCustomApplicationFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateCustomApplication, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ApplicationsApiOverview
+		return zero, fmt.Errorf("%w: CreateCustomApplication", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnCreateCustomApplicationRaw registers a stub for CreateCustomApplicationRaw calls with arguments satisfying the matchers.
+func (c *Client) OnCreateCustomApplicationRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsCreateCustomApplicationRaw = append(c.stubsCreateCustomApplicationRaw, s)
+	return s
+}
+
+// CreateCustomApplicationRaw records the call and returns the stubbed response.
+func (c *Client) CreateCustomApplicationRaw(ctx context.Context, body applications.CreateCustomApplicationJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{body}
+	c.record("CreateCustomApplicationRaw", args...)
+	if c.CreateCustomApplicationRawFunc != nil {
+		return c.CreateCustomApplicationRawFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateCustomApplicationRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: CreateCustomApplicationRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnCreateCustomApplicationWithApplicationJSONPatchPlusJSONBody registers a stub for CreateCustomApplicationWithApplicationJSONPatchPlusJSONBody calls with arguments satisfying the matchers.
+func (c *Client) OnCreateCustomApplicationWithApplicationJSONPatchPlusJSONBody(matchers ...Matcher) *Stub[*applications.ApplicationsApiOverview] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ApplicationsApiOverview]{matchers: matchers}
+	c.stubsCreateCustomApplicationWithApplicationJSONPatchPlusJSONBody = append(c.stubsCreateCustomApplicationWithApplicationJSONPatchPlusJSONBody, s)
+	return s
+}
+
+// CreateCustomApplicationWithApplicationJSONPatchPlusJSONBody records the call and returns the stubbed response.
+func (c *Client) CreateCustomApplicationWithApplicationJSONPatchPlusJSONBody(ctx context.Context, body applications.CreateCustomApplicationApplicationJSONPatchPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiOverview, error) {
+	args := []any{body}
+	c.record("CreateCustomApplicationWithApplicationJSONPatchPlusJSONBody", args...)
+	if c.CreateCustomApplicationWithApplicationJSONPatchPlusJSONBodyFunc != nil {
+		return c.CreateCustomApplicationWithApplicationJSONPatchPlusJSONBodyFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateCustomApplicationWithApplicationJSONPatchPlusJSONBody, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ApplicationsApiOverview
+		return zero, fmt.Errorf("%w: CreateCustomApplicationWithApplicationJSONPatchPlusJSONBody", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnCreateCustomApplicationWithApplicationJSONPatchPlusJSONBodyRaw registers a stub for CreateCustomApplicationWithApplicationJSONPatchPlusJSONBodyRaw calls with arguments satisfying the matchers.
+func (c *Client) OnCreateCustomApplicationWithApplicationJSONPatchPlusJSONBodyRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsCreateCustomApplicationWithApplicationJSONPatchPlusJSONBodyRaw = append(c.stubsCreateCustomApplicationWithApplicationJSONPatchPlusJSONBodyRaw, s)
+	return s
+}
+
+// CreateCustomApplicationWithApplicationJSONPatchPlusJSONBodyRaw records the call and returns the stubbed response.
+func (c *Client) CreateCustomApplicationWithApplicationJSONPatchPlusJSONBodyRaw(ctx context.Context, body applications.CreateCustomApplicationApplicationJSONPatchPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{body}
+	c.record("CreateCustomApplicationWithApplicationJSONPatchPlusJSONBodyRaw", args...)
+	if c.CreateCustomApplicationWithApplicationJSONPatchPlusJSONBodyRawFunc != nil {
+		return c.CreateCustomApplicationWithApplicationJSONPatchPlusJSONBodyRawFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateCustomApplicationWithApplicationJSONPatchPlusJSONBodyRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: CreateCustomApplicationWithApplicationJSONPatchPlusJSONBodyRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnDestroyApplication registers a stub for DestroyApplication calls with arguments satisfying the matchers.
+func (c *Client) OnDestroyApplication(matchers ...Matcher) *Stub[*applications.ApplicationsApiCommandResponse] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ApplicationsApiCommandResponse]{matchers: matchers}
+	c.stubsDestroyApplication = append(c.stubsDestroyApplication, s)
+	return s
+}
+
+// DestroyApplication records the call and returns the stubbed response.
+func (c *Client) DestroyApplication(ctx context.Context, params *applications.DestroyApplicationParams, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiCommandResponse, error) {
+	args := []any{params}
+	c.record("DestroyApplication", args...)
+	if c.DestroyApplicationFunc != nil {
+		return c.DestroyApplicationFunc(ctx, params, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsDestroyApplication, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ApplicationsApiCommandResponse
+		return zero, fmt.Errorf("%w: DestroyApplication", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnDestroyApplicationRaw registers a stub for DestroyApplicationRaw calls with arguments satisfying the matchers.
+func (c *Client) OnDestroyApplicationRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsDestroyApplicationRaw = append(c.stubsDestroyApplicationRaw, s)
+	return s
+}
+
+// DestroyApplicationRaw records the call and returns the stubbed response.
+func (c *Client) DestroyApplicationRaw(ctx context.Context, params *applications.DestroyApplicationParams, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{params}
+	c.record("DestroyApplicationRaw", args...)
+	if c.DestroyApplicationRawFunc != nil {
+		return c.DestroyApplicationRawFunc(ctx, params, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsDestroyApplicationRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: DestroyApplicationRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnGetApplicationCatalogItems registers a stub for GetApplicationCatalogItems calls with arguments satisfying the matchers.
+func (c *Client) OnGetApplicationCatalogItems(matchers ...Matcher) *Stub[*applications.ListResultDtoOfApplicationsApiCatalogItem] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ListResultDtoOfApplicationsApiCatalogItem]{matchers: matchers}
+	c.stubsGetApplicationCatalogItems = append(c.stubsGetApplicationCatalogItems, s)
+	return s
+}
+
+// GetApplicationCatalogItems records the call and returns the stubbed response.
+func (c *Client) GetApplicationCatalogItems(ctx context.Context, reqEditors ...applications.RequestEditorFn) (*applications.ListResultDtoOfApplicationsApiCatalogItem, error) {
+	args := []any{}
+	c.record("GetApplicationCatalogItems", args...)
+	if c.GetApplicationCatalogItemsFunc != nil {
+		return c.GetApplicationCatalogItemsFunc(ctx, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsGetApplicationCatalogItems, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ListResultDtoOfApplicationsApiCatalogItem
+		return zero, fmt.Errorf("%w: GetApplicationCatalogItems", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnGetApplicationCatalogItemsRaw registers a stub for GetApplicationCatalogItemsRaw calls with arguments satisfying the matchers.
+func (c *Client) OnGetApplicationCatalogItemsRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsGetApplicationCatalogItemsRaw = append(c.stubsGetApplicationCatalogItemsRaw, s)
+	return s
+}
+
+// GetApplicationCatalogItemsRaw records the call and returns the stubbed response.
+func (c *Client) GetApplicationCatalogItemsRaw(ctx context.Context, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{}
+	c.record("GetApplicationCatalogItemsRaw", args...)
+	if c.GetApplicationCatalogItemsRawFunc != nil {
+		return c.GetApplicationCatalogItemsRawFunc(ctx, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsGetApplicationCatalogItemsRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: GetApplicationCatalogItemsRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnGetApplicationDetails registers a stub for GetApplicationDetails calls with arguments satisfying the matchers.
+func (c *Client) OnGetApplicationDetails(matchers ...Matcher) *Stub[*applications.ApplicationsApiDetails] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ApplicationsApiDetails]{matchers: matchers}
+	c.stubsGetApplicationDetails = append(c.stubsGetApplicationDetails, s)
+	return s
+}
+
+// GetApplicationDetails records the call and returns the stubbed response.
+func (c *Client) GetApplicationDetails(ctx context.Context, params *applications.GetApplicationDetailsParams, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiDetails, error) {
+	args := []any{params}
+	c.record("GetApplicationDetails", args...)
+	if c.GetApplicationDetailsFunc != nil {
+		return c.GetApplicationDetailsFunc(ctx, params, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsGetApplicationDetails, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ApplicationsApiDetails
+		return zero, fmt.Errorf("%w: GetApplicationDetails", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnGetApplicationDetailsRaw registers a stub for GetApplicationDetailsRaw calls with arguments satisfying the matchers.
+func (c *Client) OnGetApplicationDetailsRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsGetApplicationDetailsRaw = append(c.stubsGetApplicationDetailsRaw, s)
+	return s
+}
+
+// GetApplicationDetailsRaw records the call and returns the stubbed response.
+func (c *Client) GetApplicationDetailsRaw(ctx context.Context, params *applications.GetApplicationDetailsParams, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{params}
+	c.record("GetApplicationDetailsRaw", args...)
+	if c.GetApplicationDetailsRawFunc != nil {
+		return c.GetApplicationDetailsRawFunc(ctx, params, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsGetApplicationDetailsRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: GetApplicationDetailsRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnGetApplicationRuntimeLogs registers a stub for GetApplicationRuntimeLogs calls with arguments satisfying the matchers.
+func (c *Client) OnGetApplicationRuntimeLogs(matchers ...Matcher) *Stub[*applications.ApplicationsApiRuntimeLogsResponse] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ApplicationsApiRuntimeLogsResponse]{matchers: matchers}
+	c.stubsGetApplicationRuntimeLogs = append(c.stubsGetApplicationRuntimeLogs, s)
+	return s
+}
+
+// GetApplicationRuntimeLogs records the call and returns the stubbed response.
+func (c *Client) GetApplicationRuntimeLogs(ctx context.Context, params *applications.GetApplicationRuntimeLogsParams, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiRuntimeLogsResponse, error) {
+	args := []any{params}
+	c.record("GetApplicationRuntimeLogs", args...)
+	if c.GetApplicationRuntimeLogsFunc != nil {
+		return c.GetApplicationRuntimeLogsFunc(ctx, params, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsGetApplicationRuntimeLogs, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ApplicationsApiRuntimeLogsResponse
+		return zero, fmt.Errorf("%w: GetApplicationRuntimeLogs", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnGetApplicationRuntimeLogsRaw registers a stub for GetApplicationRuntimeLogsRaw calls with arguments satisfying the matchers.
+func (c *Client) OnGetApplicationRuntimeLogsRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsGetApplicationRuntimeLogsRaw = append(c.stubsGetApplicationRuntimeLogsRaw, s)
+	return s
+}
+
+// GetApplicationRuntimeLogsRaw records the call and returns the stubbed response.
+func (c *Client) GetApplicationRuntimeLogsRaw(ctx context.Context, params *applications.GetApplicationRuntimeLogsParams, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{params}
+	c.record("GetApplicationRuntimeLogsRaw", args...)
+	if c.GetApplicationRuntimeLogsRawFunc != nil {
+		return c.GetApplicationRuntimeLogsRawFunc(ctx, params, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsGetApplicationRuntimeLogsRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: GetApplicationRuntimeLogsRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnGetApplications registers a stub for GetApplications calls with arguments satisfying the matchers.
+func (c *Client) OnGetApplications(matchers ...Matcher) *Stub[*applications.ListResultDtoOfApplicationsApiOverview] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ListResultDtoOfApplicationsApiOverview]{matchers: matchers}
+	c.stubsGetApplications = append(c.stubsGetApplications, s)
+	return s
+}
+
+// GetApplications records the call and returns the stubbed response.
+func (c *Client) GetApplications(ctx context.Context, reqEditors ...applications.RequestEditorFn) (*applications.ListResultDtoOfApplicationsApiOverview, error) {
+	args := []any{}
+	c.record("GetApplications", args...)
+	if c.GetApplicationsFunc != nil {
+		return c.GetApplicationsFunc(ctx, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsGetApplications, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ListResultDtoOfApplicationsApiOverview
+		return zero, fmt.Errorf("%w: GetApplications", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnGetApplicationsRaw registers a stub for GetApplicationsRaw calls with arguments satisfying the matchers.
+func (c *Client) OnGetApplicationsRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsGetApplicationsRaw = append(c.stubsGetApplicationsRaw, s)
+	return s
+}
+
+// GetApplicationsRaw records the call and returns the stubbed response.
+func (c *Client) GetApplicationsRaw(ctx context.Context, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{}
+	c.record("GetApplicationsRaw", args...)
+	if c.GetApplicationsRawFunc != nil {
+		return c.GetApplicationsRawFunc(ctx, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsGetApplicationsRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: GetApplicationsRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnGetAvailability registers a stub for GetAvailability calls with arguments satisfying the matchers.
+func (c *Client) OnGetAvailability(matchers ...Matcher) *Stub[*applications.ListResultDtoOfApplicationsApiApplicationConfigAvailability] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ListResultDtoOfApplicationsApiApplicationConfigAvailability]{matchers: matchers}
+	c.stubsGetAvailability = append(c.stubsGetAvailability, s)
+	return s
+}
+
+// GetAvailability records the call and returns the stubbed response.
+func (c *Client) GetAvailability(ctx context.Context, params *applications.GetAvailabilityParams, reqEditors ...applications.RequestEditorFn) (*applications.ListResultDtoOfApplicationsApiApplicationConfigAvailability, error) {
+	args := []any{params}
+	c.record("GetAvailability", args...)
+	if c.GetAvailabilityFunc != nil {
+		return c.GetAvailabilityFunc(ctx, params, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsGetAvailability, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ListResultDtoOfApplicationsApiApplicationConfigAvailability
+		return zero, fmt.Errorf("%w: GetAvailability", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnGetAvailabilityRaw registers a stub for GetAvailabilityRaw calls with arguments satisfying the matchers.
+func (c *Client) OnGetAvailabilityRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsGetAvailabilityRaw = append(c.stubsGetAvailabilityRaw, s)
+	return s
+}
+
+// GetAvailabilityRaw records the call and returns the stubbed response.
+func (c *Client) GetAvailabilityRaw(ctx context.Context, params *applications.GetAvailabilityParams, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{params}
+	c.record("GetAvailabilityRaw", args...)
+	if c.GetAvailabilityRawFunc != nil {
+		return c.GetAvailabilityRawFunc(ctx, params, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsGetAvailabilityRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: GetAvailabilityRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnGetConfigurations registers a stub for GetConfigurations calls with arguments satisfying the matchers.
+func (c *Client) OnGetConfigurations(matchers ...Matcher) *Stub[*applications.ListResultDtoOfApplicationsApiApplicationConfig] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ListResultDtoOfApplicationsApiApplicationConfig]{matchers: matchers}
+	c.stubsGetConfigurations = append(c.stubsGetConfigurations, s)
+	return s
+}
+
+// GetConfigurations records the call and returns the stubbed response.
+func (c *Client) GetConfigurations(ctx context.Context, reqEditors ...applications.RequestEditorFn) (*applications.ListResultDtoOfApplicationsApiApplicationConfig, error) {
+	args := []any{}
+	c.record("GetConfigurations", args...)
+	if c.GetConfigurationsFunc != nil {
+		return c.GetConfigurationsFunc(ctx, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsGetConfigurations, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ListResultDtoOfApplicationsApiApplicationConfig
+		return zero, fmt.Errorf("%w: GetConfigurations", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnGetConfigurationsRaw registers a stub for GetConfigurationsRaw calls with arguments satisfying the matchers.
+func (c *Client) OnGetConfigurationsRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsGetConfigurationsRaw = append(c.stubsGetConfigurationsRaw, s)
+	return s
+}
+
+// GetConfigurationsRaw records the call and returns the stubbed response.
+func (c *Client) GetConfigurationsRaw(ctx context.Context, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{}
+	c.record("GetConfigurationsRaw", args...)
+	if c.GetConfigurationsRawFunc != nil {
+		return c.GetConfigurationsRawFunc(ctx, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsGetConfigurationsRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: GetConfigurationsRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStartApplicationWithBody registers a stub for StartApplicationWithBody calls with arguments satisfying the matchers.
+func (c *Client) OnStartApplicationWithBody(matchers ...Matcher) *Stub[*applications.ApplicationsApiCommandResponse] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ApplicationsApiCommandResponse]{matchers: matchers}
+	c.stubsStartApplicationWithBody = append(c.stubsStartApplicationWithBody, s)
+	return s
+}
+
+// StartApplicationWithBody records the call and returns the stubbed response.
+func (c *Client) StartApplicationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiCommandResponse, error) {
+	args := []any{contentType, body}
+	c.record("StartApplicationWithBody", args...)
+	if c.StartApplicationWithBodyFunc != nil {
+		return c.StartApplicationWithBodyFunc(ctx, contentType, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStartApplicationWithBody, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ApplicationsApiCommandResponse
+		return zero, fmt.Errorf("%w: StartApplicationWithBody", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStartApplicationWithBodyRaw registers a stub for StartApplicationWithBodyRaw calls with arguments satisfying the matchers.
+func (c *Client) OnStartApplicationWithBodyRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsStartApplicationWithBodyRaw = append(c.stubsStartApplicationWithBodyRaw, s)
+	return s
+}
+
+// StartApplicationWithBodyRaw records the call and returns the stubbed response.
+func (c *Client) StartApplicationWithBodyRaw(ctx context.Context, contentType string, body io.Reader, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{contentType, body}
+	c.record("StartApplicationWithBodyRaw", args...)
+	if c.StartApplicationWithBodyRawFunc != nil {
+		return c.StartApplicationWithBodyRawFunc(ctx, contentType, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStartApplicationWithBodyRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: StartApplicationWithBodyRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStartApplicationWithApplicationWildcardPlusJSONBody registers a stub for StartApplicationWithApplicationWildcardPlusJSONBody calls with arguments satisfying the matchers.
+func (c *Client) OnStartApplicationWithApplicationWildcardPlusJSONBody(matchers ...Matcher) *Stub[*applications.ApplicationsApiCommandResponse] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ApplicationsApiCommandResponse]{matchers: matchers}
+	c.stubsStartApplicationWithApplicationWildcardPlusJSONBody = append(c.stubsStartApplicationWithApplicationWildcardPlusJSONBody, s)
+	return s
+}
+
+// StartApplicationWithApplicationWildcardPlusJSONBody records the call and returns the stubbed response.
+func (c *Client) StartApplicationWithApplicationWildcardPlusJSONBody(ctx context.Context, body applications.StartApplicationApplicationWildcardPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiCommandResponse, error) {
+	args := []any{body}
+	c.record("StartApplicationWithApplicationWildcardPlusJSONBody", args...)
+	if c.StartApplicationWithApplicationWildcardPlusJSONBodyFunc != nil {
+		return c.StartApplicationWithApplicationWildcardPlusJSONBodyFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStartApplicationWithApplicationWildcardPlusJSONBody, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ApplicationsApiCommandResponse
+		return zero, fmt.Errorf("%w: StartApplicationWithApplicationWildcardPlusJSONBody", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStartApplicationWithApplicationWildcardPlusJSONBodyRaw registers a stub for StartApplicationWithApplicationWildcardPlusJSONBodyRaw calls with arguments satisfying the matchers.
+func (c *Client) OnStartApplicationWithApplicationWildcardPlusJSONBodyRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsStartApplicationWithApplicationWildcardPlusJSONBodyRaw = append(c.stubsStartApplicationWithApplicationWildcardPlusJSONBodyRaw, s)
+	return s
+}
+
+// StartApplicationWithApplicationWildcardPlusJSONBodyRaw records the call and returns the stubbed response.
+func (c *Client) StartApplicationWithApplicationWildcardPlusJSONBodyRaw(ctx context.Context, body applications.StartApplicationApplicationWildcardPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{body}
+	c.record("StartApplicationWithApplicationWildcardPlusJSONBodyRaw", args...)
+	if c.StartApplicationWithApplicationWildcardPlusJSONBodyRawFunc != nil {
+		return c.StartApplicationWithApplicationWildcardPlusJSONBodyRawFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStartApplicationWithApplicationWildcardPlusJSONBodyRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: StartApplicationWithApplicationWildcardPlusJSONBodyRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStartApplication registers a stub for StartApplication calls with arguments satisfying the matchers.
+func (c *Client) OnStartApplication(matchers ...Matcher) *Stub[*applications.ApplicationsApiCommandResponse] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ApplicationsApiCommandResponse]{matchers: matchers}
+	c.stubsStartApplication = append(c.stubsStartApplication, s)
+	return s
+}
+
+// StartApplication records the call and returns the stubbed response.
+func (c *Client) StartApplication(ctx context.Context, body applications.StartApplicationJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiCommandResponse, error) {
+	args := []any{body}
+	c.record("StartApplication", args...)
+	if c.StartApplicationFunc != nil {
+		return c.StartApplicationFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStartApplication, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ApplicationsApiCommandResponse
+		return zero, fmt.Errorf("%w: StartApplication", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStartApplicationRaw registers a stub for StartApplicationRaw calls with arguments satisfying the matchers.
+func (c *Client) OnStartApplicationRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsStartApplicationRaw = append(c.stubsStartApplicationRaw, s)
+	return s
+}
+
+// StartApplicationRaw records the call and returns the stubbed response.
+func (c *Client) StartApplicationRaw(ctx context.Context, body applications.StartApplicationJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{body}
+	c.record("StartApplicationRaw", args...)
+	if c.StartApplicationRawFunc != nil {
+		return c.StartApplicationRawFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStartApplicationRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: StartApplicationRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStartApplicationWithApplicationJSONPatchPlusJSONBody registers a stub for StartApplicationWithApplicationJSONPatchPlusJSONBody calls with arguments satisfying the matchers.
+func (c *Client) OnStartApplicationWithApplicationJSONPatchPlusJSONBody(matchers ...Matcher) *Stub[*applications.ApplicationsApiCommandResponse] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ApplicationsApiCommandResponse]{matchers: matchers}
+	c.stubsStartApplicationWithApplicationJSONPatchPlusJSONBody = append(c.stubsStartApplicationWithApplicationJSONPatchPlusJSONBody, s)
+	return s
+}
+
+// StartApplicationWithApplicationJSONPatchPlusJSONBody records the call and returns the stubbed response.
+func (c *Client) StartApplicationWithApplicationJSONPatchPlusJSONBody(ctx context.Context, body applications.StartApplicationApplicationJSONPatchPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiCommandResponse, error) {
+	args := []any{body}
+	c.record("StartApplicationWithApplicationJSONPatchPlusJSONBody", args...)
+	if c.StartApplicationWithApplicationJSONPatchPlusJSONBodyFunc != nil {
+		return c.StartApplicationWithApplicationJSONPatchPlusJSONBodyFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStartApplicationWithApplicationJSONPatchPlusJSONBody, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ApplicationsApiCommandResponse
+		return zero, fmt.Errorf("%w: StartApplicationWithApplicationJSONPatchPlusJSONBody", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStartApplicationWithApplicationJSONPatchPlusJSONBodyRaw registers a stub for StartApplicationWithApplicationJSONPatchPlusJSONBodyRaw calls with arguments satisfying the matchers.
+func (c *Client) OnStartApplicationWithApplicationJSONPatchPlusJSONBodyRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsStartApplicationWithApplicationJSONPatchPlusJSONBodyRaw = append(c.stubsStartApplicationWithApplicationJSONPatchPlusJSONBodyRaw, s)
+	return s
+}
+
+// StartApplicationWithApplicationJSONPatchPlusJSONBodyRaw records the call and returns the stubbed response.
+func (c *Client) StartApplicationWithApplicationJSONPatchPlusJSONBodyRaw(ctx context.Context, body applications.StartApplicationApplicationJSONPatchPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{body}
+	c.record("StartApplicationWithApplicationJSONPatchPlusJSONBodyRaw", args...)
+	if c.StartApplicationWithApplicationJSONPatchPlusJSONBodyRawFunc != nil {
+		return c.StartApplicationWithApplicationJSONPatchPlusJSONBodyRawFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStartApplicationWithApplicationJSONPatchPlusJSONBodyRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: StartApplicationWithApplicationJSONPatchPlusJSONBodyRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStopApplicationWithBody registers a stub for StopApplicationWithBody calls with arguments satisfying the matchers.
+func (c *Client) OnStopApplicationWithBody(matchers ...Matcher) *Stub[*applications.ApplicationsApiCommandResponse] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ApplicationsApiCommandResponse]{matchers: matchers}
+	c.stubsStopApplicationWithBody = append(c.stubsStopApplicationWithBody, s)
+	return s
+}
+
+// StopApplicationWithBody records the call and returns the stubbed response.
+func (c *Client) StopApplicationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiCommandResponse, error) {
+	args := []any{contentType, body}
+	c.record("StopApplicationWithBody", args...)
+	if c.StopApplicationWithBodyFunc != nil {
+		return c.StopApplicationWithBodyFunc(ctx, contentType, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStopApplicationWithBody, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ApplicationsApiCommandResponse
+		return zero, fmt.Errorf("%w: StopApplicationWithBody", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStopApplicationWithBodyRaw registers a stub for StopApplicationWithBodyRaw calls with arguments satisfying the matchers.
+func (c *Client) OnStopApplicationWithBodyRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsStopApplicationWithBodyRaw = append(c.stubsStopApplicationWithBodyRaw, s)
+	return s
+}
+
+// StopApplicationWithBodyRaw records the call and returns the stubbed response.
+func (c *Client) StopApplicationWithBodyRaw(ctx context.Context, contentType string, body io.Reader, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{contentType, body}
+	c.record("StopApplicationWithBodyRaw", args...)
+	if c.StopApplicationWithBodyRawFunc != nil {
+		return c.StopApplicationWithBodyRawFunc(ctx, contentType, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStopApplicationWithBodyRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: StopApplicationWithBodyRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStopApplicationWithApplicationWildcardPlusJSONBody registers a stub for StopApplicationWithApplicationWildcardPlusJSONBody calls with arguments satisfying the matchers.
+func (c *Client) OnStopApplicationWithApplicationWildcardPlusJSONBody(matchers ...Matcher) *Stub[*applications.ApplicationsApiCommandResponse] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ApplicationsApiCommandResponse]{matchers: matchers}
+	c.stubsStopApplicationWithApplicationWildcardPlusJSONBody = append(c.stubsStopApplicationWithApplicationWildcardPlusJSONBody, s)
+	return s
+}
+
+// StopApplicationWithApplicationWildcardPlusJSONBody records the call and returns the stubbed response.
+func (c *Client) StopApplicationWithApplicationWildcardPlusJSONBody(ctx context.Context, body applications.StopApplicationApplicationWildcardPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiCommandResponse, error) {
+	args := []any{body}
+	c.record("StopApplicationWithApplicationWildcardPlusJSONBody", args...)
+	if c.StopApplicationWithApplicationWildcardPlusJSONBodyFunc != nil {
+		return c.StopApplicationWithApplicationWildcardPlusJSONBodyFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStopApplicationWithApplicationWildcardPlusJSONBody, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ApplicationsApiCommandResponse
+		return zero, fmt.Errorf("%w: StopApplicationWithApplicationWildcardPlusJSONBody", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStopApplicationWithApplicationWildcardPlusJSONBodyRaw registers a stub for StopApplicationWithApplicationWildcardPlusJSONBodyRaw calls with arguments satisfying the matchers.
+func (c *Client) OnStopApplicationWithApplicationWildcardPlusJSONBodyRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsStopApplicationWithApplicationWildcardPlusJSONBodyRaw = append(c.stubsStopApplicationWithApplicationWildcardPlusJSONBodyRaw, s)
+	return s
+}
+
+// StopApplicationWithApplicationWildcardPlusJSONBodyRaw records the call and returns the stubbed response.
+func (c *Client) StopApplicationWithApplicationWildcardPlusJSONBodyRaw(ctx context.Context, body applications.StopApplicationApplicationWildcardPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{body}
+	c.record("StopApplicationWithApplicationWildcardPlusJSONBodyRaw", args...)
+	if c.StopApplicationWithApplicationWildcardPlusJSONBodyRawFunc != nil {
+		return c.StopApplicationWithApplicationWildcardPlusJSONBodyRawFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStopApplicationWithApplicationWildcardPlusJSONBodyRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: StopApplicationWithApplicationWildcardPlusJSONBodyRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStopApplication registers a stub for StopApplication calls with arguments satisfying the matchers.
+func (c *Client) OnStopApplication(matchers ...Matcher) *Stub[*applications.ApplicationsApiCommandResponse] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ApplicationsApiCommandResponse]{matchers: matchers}
+	c.stubsStopApplication = append(c.stubsStopApplication, s)
+	return s
+}
+
+// StopApplication records the call and returns the stubbed response.
+func (c *Client) StopApplication(ctx context.Context, body applications.StopApplicationJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiCommandResponse, error) {
+	args := []any{body}
+	c.record("StopApplication", args...)
+	if c.StopApplicationFunc != nil {
+		return c.StopApplicationFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStopApplication, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ApplicationsApiCommandResponse
+		return zero, fmt.Errorf("%w: StopApplication", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStopApplicationRaw registers a stub for StopApplicationRaw calls with arguments satisfying the matchers.
+func (c *Client) OnStopApplicationRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsStopApplicationRaw = append(c.stubsStopApplicationRaw, s)
+	return s
+}
+
+// StopApplicationRaw records the call and returns the stubbed response.
+func (c *Client) StopApplicationRaw(ctx context.Context, body applications.StopApplicationJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{body}
+	c.record("StopApplicationRaw", args...)
+	if c.StopApplicationRawFunc != nil {
+		return c.StopApplicationRawFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStopApplicationRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: StopApplicationRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStopApplicationWithApplicationJSONPatchPlusJSONBody registers a stub for StopApplicationWithApplicationJSONPatchPlusJSONBody calls with arguments satisfying the matchers.
+func (c *Client) OnStopApplicationWithApplicationJSONPatchPlusJSONBody(matchers ...Matcher) *Stub[*applications.ApplicationsApiCommandResponse] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*applications.ApplicationsApiCommandResponse]{matchers: matchers}
+	c.stubsStopApplicationWithApplicationJSONPatchPlusJSONBody = append(c.stubsStopApplicationWithApplicationJSONPatchPlusJSONBody, s)
+	return s
+}
+
+// StopApplicationWithApplicationJSONPatchPlusJSONBody records the call and returns the stubbed response.
+func (c *Client) StopApplicationWithApplicationJSONPatchPlusJSONBody(ctx context.Context, body applications.StopApplicationApplicationJSONPatchPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*applications.ApplicationsApiCommandResponse, error) {
+	args := []any{body}
+	c.record("StopApplicationWithApplicationJSONPatchPlusJSONBody", args...)
+	if c.StopApplicationWithApplicationJSONPatchPlusJSONBodyFunc != nil {
+		return c.StopApplicationWithApplicationJSONPatchPlusJSONBodyFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStopApplicationWithApplicationJSONPatchPlusJSONBody, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *applications.ApplicationsApiCommandResponse
+		return zero, fmt.Errorf("%w: StopApplicationWithApplicationJSONPatchPlusJSONBody", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStopApplicationWithApplicationJSONPatchPlusJSONBodyRaw registers a stub for StopApplicationWithApplicationJSONPatchPlusJSONBodyRaw calls with arguments satisfying the matchers.
+func (c *Client) OnStopApplicationWithApplicationJSONPatchPlusJSONBodyRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsStopApplicationWithApplicationJSONPatchPlusJSONBodyRaw = append(c.stubsStopApplicationWithApplicationJSONPatchPlusJSONBodyRaw, s)
+	return s
+}
+
+// StopApplicationWithApplicationJSONPatchPlusJSONBodyRaw records the call and returns the stubbed response.
+func (c *Client) StopApplicationWithApplicationJSONPatchPlusJSONBodyRaw(ctx context.Context, body applications.StopApplicationApplicationJSONPatchPlusJSONRequestBody, reqEditors ...applications.RequestEditorFn) (*http.Response, error) {
+	args := []any{body}
+	c.record("StopApplicationWithApplicationJSONPatchPlusJSONBodyRaw", args...)
+	if c.StopApplicationWithApplicationJSONPatchPlusJSONBodyRawFunc != nil {
+		return c.StopApplicationWithApplicationJSONPatchPlusJSONBodyRawFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStopApplicationWithApplicationJSONPatchPlusJSONBodyRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: StopApplicationWithApplicationJSONPatchPlusJSONBodyRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
@@ -0,0 +1,165 @@
+package applications
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	envNamePattern    = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	proxyKeyPattern   = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	scriptNamePattern = regexp.MustCompile(`^[A-Za-z0-9. ()]+$`)
+)
+
+const (
+	maxProxyApiKeys        = 10
+	maxProxyApiKeyLength   = 512
+	maxScriptNameLength    = 255
+	maxScriptContentLength = 16384
+	reservedProxyPort      = 443
+)
+
+// FieldError describes a single constraint violation on a request field.
+type FieldError struct {
+	// Field is the JSON path of the offending field (e.g., `environmentVariables["1FOO"]`)
+	Field   string
+	Message string
+}
+
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// ValidationError collects all of the FieldErrors found while validating a request.
+type ValidationError []FieldError
+
+func (e ValidationError) Error() string {
+	msgs := make([]string, len(e))
+	for i, fe := range e {
+		msgs[i] = fe.Error()
+	}
+	return fmt.Sprintf("%d validation error(s): %s", len(e), strings.Join(msgs, "; "))
+}
+
+// Validate checks the request against the constraints documented by the API
+// and returns a ValidationError listing every violation, or nil if the request is valid.
+func (r ApplicationsApiCustomApiCreateRequest) Validate() error {
+	var errs ValidationError
+	add := func(field string, format string, args ...any) {
+		errs = append(errs, FieldError{field, fmt.Sprintf(format, args...)})
+	}
+
+	// Required fields
+	if strings.TrimSpace(r.Name) == "" {
+		add("name", "must not be empty")
+	}
+	if strings.TrimSpace(r.Cluster) == "" {
+		add("cluster", "must not be empty")
+	}
+	if strings.TrimSpace(r.HardwarePackageName) == "" {
+		add("hardwarePackageName", "must not be empty")
+	}
+	if strings.TrimSpace(r.ImageUrl) == "" {
+		add("imageUrl", "must not be empty")
+	}
+
+	if r.EnvironmentVariables != nil {
+		for _, name := range slices.Sorted(maps.Keys(*r.EnvironmentVariables)) {
+			field := fmt.Sprintf("environmentVariables[%q]", name)
+			if !envNamePattern.MatchString(name) {
+				add(field, "name must start with a letter or underscore and contain only alphanumeric characters and underscores")
+			}
+			if value := (*r.EnvironmentVariables)[name]; value != nil && strings.ContainsAny(*value, "\x00\r\n") {
+				add(field, "value must not contain null characters, carriage returns or newlines")
+			}
+		}
+	}
+
+	if r.ImageCmdOverride != nil {
+		if len(*r.ImageCmdOverride) == 0 {
+			add("imageCmdOverride", "must be a non-empty JSON array when set")
+		}
+		for i, arg := range *r.ImageCmdOverride {
+			if arg == "" {
+				add(fmt.Sprintf("imageCmdOverride[%d]", i), "must not be empty")
+			}
+		}
+	}
+
+	if r.ProxyApiKeys != nil {
+		keys := *r.ProxyApiKeys
+		if len(keys) > maxProxyApiKeys {
+			add("proxyApiKeys", "must not contain more than %d keys, got %d", maxProxyApiKeys, len(keys))
+		}
+		if len(keys) > 0 && r.ProxyPort == nil {
+			add("proxyApiKeys", "requires proxyPort to be set")
+		}
+		for i, key := range keys {
+			field := fmt.Sprintf("proxyApiKeys[%d]", i)
+			if strings.TrimSpace(key) == "" {
+				add(field, "must not be empty or whitespace")
+			} else if !proxyKeyPattern.MatchString(key) {
+				add(field, "must contain only alphanumeric characters, hyphens and underscores")
+			}
+			if utf8.RuneCountInString(key) > maxProxyApiKeyLength {
+				add(field, "must not exceed %d characters", maxProxyApiKeyLength)
+			}
+		}
+	}
+
+	if r.ProxyPort != nil {
+		if *r.ProxyPort == reservedProxyPort {
+			add("proxyPort", "port %d is reserved for the reverse proxy", reservedProxyPort)
+		} else if *r.ProxyPort < 1 || *r.ProxyPort > 65535 {
+			add("proxyPort", "must be between 1 and 65535, got %d", *r.ProxyPort)
+		}
+	}
+
+	if r.ReadinessWatcherPort != nil && (*r.ReadinessWatcherPort < 1 || *r.ReadinessWatcherPort > 65535) {
+		add("readinessWatcherPort", "must be between 1 and 65535, got %d", *r.ReadinessWatcherPort)
+	}
+
+	if r.SecurityContext != nil {
+		sc := r.SecurityContext
+		if sc.RunAsRoot != nil && !*sc.RunAsRoot {
+			if sc.ContainerUid == nil {
+				add("securityContext.containerUid", "is required when runAsRoot is false")
+			}
+			if sc.ContainerGid == nil {
+				add("securityContext.containerGid", "is required when runAsRoot is false")
+			}
+		}
+	}
+
+	if r.UserScripts != nil {
+		for _, name := range slices.Sorted(maps.Keys(*r.UserScripts)) {
+			field := fmt.Sprintf("userScripts[%q]", name)
+			if !scriptNamePattern.MatchString(name) {
+				add(field, "name must contain only alphanumeric characters, dots, spaces and parentheses")
+			}
+			if utf8.RuneCountInString(name) > maxScriptNameLength {
+				add(field, "name must not exceed %d characters", maxScriptNameLength)
+			}
+			content := (*r.UserScripts)[name]
+			if content == nil || *content == "" {
+				add(field, "content must not be empty")
+			} else {
+				if utf8.RuneCountInString(*content) > maxScriptContentLength {
+					add(field, "content must not exceed %d characters", maxScriptContentLength)
+				}
+				if strings.ContainsRune(*content, 0) {
+					add(field, "content must not contain null characters")
+				}
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package applications_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/stretchr/testify/assert"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func TestValidate(t *testing.T) {
+	valid := func() applications.ApplicationsApiCustomApiCreateRequest {
+		return applications.ApplicationsApiCustomApiCreateRequest{
+			Name:                "my-app",
+			Cluster:             "Msc1",
+			HardwarePackageName: "g-nvidia-1xa100-40gb-pcie-14vcpu-112gb",
+			ImageUrl:            "docker.io/library/nginx:latest",
+			EnvironmentVariables: &map[string]*string{
+				"HF_HOME": ptr("/cache"),
+				"_DEBUG":  nil,
+			},
+			ImageCmdOverride: &[]string{"python", "train.py"},
+			ProxyApiKeys:     &[]string{"key-1", "key_2"},
+			ProxyPort:        ptr(int32(8080)),
+			SecurityContext: &applications.SecurityContextDto{
+				RunAsRoot:    ptr(false),
+				ContainerUid: ptr(int32(1000)),
+				ContainerGid: ptr(int32(1000)),
+			},
+			UserScripts: &map[string]*string{"setup (1).sh": ptr("echo hello")},
+		}
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		assert.NoError(t, valid().Validate())
+	})
+
+	t.Run(
+		"AllViolations",
+		func(t *testing.T) {
+			req := valid()
+			req.Name = ""
+			req.EnvironmentVariables = &map[string]*string{"1FOO": ptr("a\nb")}
+			req.ImageCmdOverride = &[]string{}
+			req.ProxyApiKeys = &[]string{"bad key!", strings.Repeat("a", 513)}
+			req.ProxyPort = ptr(int32(443))
+			req.SecurityContext = &applications.SecurityContextDto{RunAsRoot: ptr(false)}
+			req.UserScripts = &map[string]*string{"run/me.sh": ptr(""), "big.sh": ptr(strings.Repeat("x", 16385))}
+
+			err := req.Validate()
+			var verr applications.ValidationError
+			assert.ErrorAs(t, err, &verr)
+
+			fields := []string{}
+			for _, fe := range verr {
+				fields = append(fields, fe.Field)
+			}
+			assert.Equal(
+				t,
+				[]string{
+					"name",
+					`environmentVariables["1FOO"]`,
+					`environmentVariables["1FOO"]`,
+					"imageCmdOverride",
+					"proxyApiKeys[0]",
+					"proxyApiKeys[1]",
+					"proxyPort",
+					"securityContext.containerUid",
+					"securityContext.containerGid",
+					`userScripts["big.sh"]`,
+					`userScripts["run/me.sh"]`,
+					`userScripts["run/me.sh"]`,
+				},
+				fields,
+			)
+			assert.Contains(t, err.Error(), "12 validation error(s)")
+			assert.Contains(t, err.Error(), "port 443 is reserved")
+		},
+	)
+
+	t.Run(
+		"Characters",
+		func(t *testing.T) {
+			// The lengths are in characters, so multi-byte content up to the limit is accepted
+			req := valid()
+			req.UserScripts = &map[string]*string{"setup.sh": ptr(strings.Repeat("é", 16384))}
+			assert.NoError(t, req.Validate())
+
+			req.UserScripts = &map[string]*string{"setup.sh": ptr(strings.Repeat("é", 16385))}
+			assert.ErrorContains(t, req.Validate(), "content must not exceed 16384 characters")
+		},
+	)
+
+	t.Run(
+		"TooManyProxyKeys",
+		func(t *testing.T) {
+			req := valid()
+			req.ProxyApiKeys = &[]string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"}
+			req.ProxyPort = nil
+
+			err := req.Validate()
+			assert.ErrorContains(t, err, "proxyApiKeys: must not contain more than 10 keys, got 11")
+			assert.ErrorContains(t, err, "proxyApiKeys: requires proxyPort to be set")
+		},
+	)
+}
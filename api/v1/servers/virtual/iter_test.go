@@ -0,0 +1,37 @@
+package virtual_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/denvrtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllServers(t *testing.T) {
+	body := `{"items": [{"id": "vm-1", "cluster": "Msc1"}, {"id": "vm-2", "cluster": "Hou1"}]}`
+	server := denvrtest.NewServer(t)
+	server.HandleFunc(
+		"/api/v1/servers/virtual/GetServers",
+		func(resp http.ResponseWriter, req *http.Request) {
+			resp.WriteHeader(http.StatusOK)
+			resp.Write([]byte(body))
+		},
+	)
+	c := server.Virtual()
+
+	ids := []string{}
+	for vm, err := range virtual.AllServers(context.TODO(), c, &virtual.GetServersParams{}) {
+		assert.NoError(t, err)
+		ids = append(ids, *vm.Id)
+	}
+	assert.Equal(t, []string{"vm-1", "vm-2"}, ids)
+
+	// A null items list should simply yield nothing
+	body = `{"items": null}`
+	for range virtual.AllServers(context.TODO(), c, nil) {
+		t.Fatal("expected no servers")
+	}
+}
@@ -0,0 +1,1209 @@
+// Package mock provides a programmable fake of virtual.ClientInterface for tests.
+//
+// Code generated by github.com/denvrdata/go-denvr/tools/mockgen DO NOT EDIT.
+package mock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+)
+
+// ErrNotStubbed is returned by calls without a stub func or a matching stub.
+var ErrNotStubbed = errors.New("mock: call not stubbed")
+
+// Call is a recorded call. Args excludes the context and request editors.
+type Call struct {
+	Method string
+	Args   []any
+}
+
+// Matches reports whether the arguments of the call satisfy the matchers, in order.
+// Arguments without a matcher are ignored.
+func (c Call) Matches(matchers ...Matcher) bool {
+	if len(matchers) > len(c.Args) {
+		return false
+	}
+	for i, m := range matchers {
+		if !m.Match(c.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Matcher matches a call argument.
+type Matcher interface {
+	Match(arg any) bool
+}
+
+// MatcherFunc adapts a function to a Matcher.
+type MatcherFunc func(arg any) bool
+
+func (f MatcherFunc) Match(arg any) bool {
+	return f(arg)
+}
+
+// Any matches every argument.
+func Any() Matcher {
+	return MatcherFunc(func(any) bool { return true })
+}
+
+// Eq matches arguments deeply equal to v. Pointers are compared by the values they point to.
+func Eq(v any) Matcher {
+	want := indirect(v)
+	return MatcherFunc(func(arg any) bool { return reflect.DeepEqual(indirect(arg), want) })
+}
+
+// Func matches arguments of type T for which f returns true.
+func Func[T any](f func(T) bool) Matcher {
+	return MatcherFunc(func(arg any) bool {
+		v, ok := arg.(T)
+		return ok && f(v)
+	})
+}
+
+func indirect(v any) any {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return nil
+	}
+	return rv.Interface()
+}
+
+// Stub returns a canned response from calls with matching arguments.
+type Stub[T any] struct {
+	matchers []Matcher
+	rsp      T
+	err      error
+	// times is the number of calls the stub answers, unlimited if zero
+	times int
+	used  int
+}
+
+// Return sets the response and error returned by the stub.
+func (s *Stub[T]) Return(rsp T, err error) *Stub[T] {
+	s.rsp, s.err = rsp, err
+	return s
+}
+
+// Times limits the number of calls answered by the stub, after which later stubs are considered.
+func (s *Stub[T]) Times(n int) *Stub[T] {
+	s.times = n
+	return s
+}
+
+func answer[T any](stubs []*Stub[T], args []any) (*Stub[T], bool) {
+	for _, s := range stubs {
+		if (s.times == 0 || s.used < s.times) && (Call{Args: args}).Matches(s.matchers...) {
+			s.used++
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+// Client is a programmable virtual.ClientInterface.
+//
+// Each call is recorded, then answered by its stub func if set, otherwise by the first
+// matching stub registered with the corresponding On method, otherwise with ErrNotStubbed.
+type Client struct {
+	CreateServerWithBodyFunc                                func(ctx context.Context, contentType string, body io.Reader, reqEditors ...virtual.RequestEditorFn) (*virtual.VirtualServerDetailsItem, error)
+	CreateServerWithBodyRawFunc                             func(ctx context.Context, contentType string, body io.Reader, reqEditors ...virtual.RequestEditorFn) (*http.Response, error)
+	CreateServerWithApplicationWildcardPlusJSONBodyFunc     func(ctx context.Context, body virtual.CreateServerApplicationWildcardPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*virtual.VirtualServerDetailsItem, error)
+	CreateServerWithApplicationWildcardPlusJSONBodyRawFunc  func(ctx context.Context, body virtual.CreateServerApplicationWildcardPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*http.Response, error)
+	CreateServerFunc                                        func(ctx context.Context, body virtual.CreateServerJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*virtual.VirtualServerDetailsItem, error)
+	CreateServerRawFunc                                     func(ctx context.Context, body virtual.CreateServerJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*http.Response, error)
+	CreateServerWithApplicationJSONPatchPlusJSONBodyFunc    func(ctx context.Context, body virtual.CreateServerApplicationJSONPatchPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*virtual.VirtualServerDetailsItem, error)
+	CreateServerWithApplicationJSONPatchPlusJSONBodyRawFunc func(ctx context.Context, body virtual.CreateServerApplicationJSONPatchPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*http.Response, error)
+	DestroyServerFunc                                       func(ctx context.Context, params *virtual.DestroyServerParams, reqEditors ...virtual.RequestEditorFn) (*virtual.ServerCommandOutput, error)
+	DestroyServerRawFunc                                    func(ctx context.Context, params *virtual.DestroyServerParams, reqEditors ...virtual.RequestEditorFn) (*http.Response, error)
+	GetAvailabilityFunc                                     func(ctx context.Context, params *virtual.GetAvailabilityParams, reqEditors ...virtual.RequestEditorFn) (*virtual.ListResultDtoOfServerAvailability, error)
+	GetAvailabilityRawFunc                                  func(ctx context.Context, params *virtual.GetAvailabilityParams, reqEditors ...virtual.RequestEditorFn) (*http.Response, error)
+	GetConfigurationsFunc                                   func(ctx context.Context, reqEditors ...virtual.RequestEditorFn) (*virtual.ListResultDtoOfServerConfiguration, error)
+	GetConfigurationsRawFunc                                func(ctx context.Context, reqEditors ...virtual.RequestEditorFn) (*http.Response, error)
+	GetServerFunc                                           func(ctx context.Context, params *virtual.GetServerParams, reqEditors ...virtual.RequestEditorFn) (*virtual.VirtualServerDetailsItem, error)
+	GetServerRawFunc                                        func(ctx context.Context, params *virtual.GetServerParams, reqEditors ...virtual.RequestEditorFn) (*http.Response, error)
+	GetServersFunc                                          func(ctx context.Context, params *virtual.GetServersParams, reqEditors ...virtual.RequestEditorFn) (*virtual.ListResultDtoOfVirtualServerDetailsItem, error)
+	GetServersRawFunc                                       func(ctx context.Context, params *virtual.GetServersParams, reqEditors ...virtual.RequestEditorFn) (*http.Response, error)
+	GetVirtualMachineBootLogsFunc                           func(ctx context.Context, params *virtual.GetVirtualMachineBootLogsParams, reqEditors ...virtual.RequestEditorFn) (*virtual.ServerBootLogsOutput, error)
+	GetVirtualMachineBootLogsRawFunc                        func(ctx context.Context, params *virtual.GetVirtualMachineBootLogsParams, reqEditors ...virtual.RequestEditorFn) (*http.Response, error)
+	StartServerWithBodyFunc                                 func(ctx context.Context, contentType string, body io.Reader, reqEditors ...virtual.RequestEditorFn) (*virtual.ServerCommandOutput, error)
+	StartServerWithBodyRawFunc                              func(ctx context.Context, contentType string, body io.Reader, reqEditors ...virtual.RequestEditorFn) (*http.Response, error)
+	StartServerWithApplicationWildcardPlusJSONBodyFunc      func(ctx context.Context, body virtual.StartServerApplicationWildcardPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*virtual.ServerCommandOutput, error)
+	StartServerWithApplicationWildcardPlusJSONBodyRawFunc   func(ctx context.Context, body virtual.StartServerApplicationWildcardPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*http.Response, error)
+	StartServerFunc                                         func(ctx context.Context, body virtual.StartServerJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*virtual.ServerCommandOutput, error)
+	StartServerRawFunc                                      func(ctx context.Context, body virtual.StartServerJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*http.Response, error)
+	StartServerWithApplicationJSONPatchPlusJSONBodyFunc     func(ctx context.Context, body virtual.StartServerApplicationJSONPatchPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*virtual.ServerCommandOutput, error)
+	StartServerWithApplicationJSONPatchPlusJSONBodyRawFunc  func(ctx context.Context, body virtual.StartServerApplicationJSONPatchPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*http.Response, error)
+	StopServerWithBodyFunc                                  func(ctx context.Context, contentType string, body io.Reader, reqEditors ...virtual.RequestEditorFn) (*virtual.ServerCommandOutput, error)
+	StopServerWithBodyRawFunc                               func(ctx context.Context, contentType string, body io.Reader, reqEditors ...virtual.RequestEditorFn) (*http.Response, error)
+	StopServerWithApplicationWildcardPlusJSONBodyFunc       func(ctx context.Context, body virtual.StopServerApplicationWildcardPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*virtual.ServerCommandOutput, error)
+	StopServerWithApplicationWildcardPlusJSONBodyRawFunc    func(ctx context.Context, body virtual.StopServerApplicationWildcardPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*http.Response, error)
+	StopServerFunc                                          func(ctx context.Context, body virtual.StopServerJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*virtual.ServerCommandOutput, error)
+	StopServerRawFunc                                       func(ctx context.Context, body virtual.StopServerJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*http.Response, error)
+	StopServerWithApplicationJSONPatchPlusJSONBodyFunc      func(ctx context.Context, body virtual.StopServerApplicationJSONPatchPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*virtual.ServerCommandOutput, error)
+	StopServerWithApplicationJSONPatchPlusJSONBodyRawFunc   func(ctx context.Context, body virtual.StopServerApplicationJSONPatchPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*http.Response, error)
+
+	mu                                                       sync.Mutex
+	calls                                                    []Call
+	stubsCreateServerWithBody                                []*Stub[*virtual.VirtualServerDetailsItem]
+	stubsCreateServerWithBodyRaw                             []*Stub[*http.Response]
+	stubsCreateServerWithApplicationWildcardPlusJSONBody     []*Stub[*virtual.VirtualServerDetailsItem]
+	stubsCreateServerWithApplicationWildcardPlusJSONBodyRaw  []*Stub[*http.Response]
+	stubsCreateServer                                        []*Stub[*virtual.VirtualServerDetailsItem]
+	stubsCreateServerRaw                                     []*Stub[*http.Response]
+	stubsCreateServerWithApplicationJSONPatchPlusJSONBody    []*Stub[*virtual.VirtualServerDetailsItem]
+	stubsCreateServerWithApplicationJSONPatchPlusJSONBodyRaw []*Stub[*http.Response]
+	stubsDestroyServer                                       []*Stub[*virtual.ServerCommandOutput]
+	stubsDestroyServerRaw                                    []*Stub[*http.Response]
+	stubsGetAvailability                                     []*Stub[*virtual.ListResultDtoOfServerAvailability]
+	stubsGetAvailabilityRaw                                  []*Stub[*http.Response]
+	stubsGetConfigurations                                   []*Stub[*virtual.ListResultDtoOfServerConfiguration]
+	stubsGetConfigurationsRaw                                []*Stub[*http.Response]
+	stubsGetServer                                           []*Stub[*virtual.VirtualServerDetailsItem]
+	stubsGetServerRaw                                        []*Stub[*http.Response]
+	stubsGetServers                                          []*Stub[*virtual.ListResultDtoOfVirtualServerDetailsItem]
+	stubsGetServersRaw                                       []*Stub[*http.Response]
+	stubsGetVirtualMachineBootLogs                           []*Stub[*virtual.ServerBootLogsOutput]
+	stubsGetVirtualMachineBootLogsRaw                        []*Stub[*http.Response]
+	stubsStartServerWithBody                                 []*Stub[*virtual.ServerCommandOutput]
+	stubsStartServerWithBodyRaw                              []*Stub[*http.Response]
+	stubsStartServerWithApplicationWildcardPlusJSONBody      []*Stub[*virtual.ServerCommandOutput]
+	stubsStartServerWithApplicationWildcardPlusJSONBodyRaw   []*Stub[*http.Response]
+	stubsStartServer                                         []*Stub[*virtual.ServerCommandOutput]
+	stubsStartServerRaw                                      []*Stub[*http.Response]
+	stubsStartServerWithApplicationJSONPatchPlusJSONBody     []*Stub[*virtual.ServerCommandOutput]
+	stubsStartServerWithApplicationJSONPatchPlusJSONBodyRaw  []*Stub[*http.Response]
+	stubsStopServerWithBody                                  []*Stub[*virtual.ServerCommandOutput]
+	stubsStopServerWithBodyRaw                               []*Stub[*http.Response]
+	stubsStopServerWithApplicationWildcardPlusJSONBody       []*Stub[*virtual.ServerCommandOutput]
+	stubsStopServerWithApplicationWildcardPlusJSONBodyRaw    []*Stub[*http.Response]
+	stubsStopServer                                          []*Stub[*virtual.ServerCommandOutput]
+	stubsStopServerRaw                                       []*Stub[*http.Response]
+	stubsStopServerWithApplicationJSONPatchPlusJSONBody      []*Stub[*virtual.ServerCommandOutput]
+	stubsStopServerWithApplicationJSONPatchPlusJSONBodyRaw   []*Stub[*http.Response]
+}
+
+var _ virtual.ClientInterface = (*Client)(nil)
+
+// Calls returns the recorded calls to the method, or every call if method is empty.
+func (c *Client) Calls(method string) []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if method == "" {
+		return slices.Clone(c.calls)
+	}
+	var calls []Call
+	for _, call := range c.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Called reports whether the method was called with arguments satisfying the matchers.
+func (c *Client) Called(method string, matchers ...Matcher) bool {
+	for _, call := range c.Calls(method) {
+		if call.Matches(matchers...) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reset clears the recorded calls and registered stubs. Stub funcs are kept.
+func (c *Client) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = nil
+	c.stubsCreateServerWithBody = nil
+	c.stubsCreateServerWithBodyRaw = nil
+	c.stubsCreateServerWithApplicationWildcardPlusJSONBody = nil
+	c.stubsCreateServerWithApplicationWildcardPlusJSONBodyRaw = nil
+	c.stubsCreateServer = nil
+	c.stubsCreateServerRaw = nil
+	c.stubsCreateServerWithApplicationJSONPatchPlusJSONBody = nil
+	c.stubsCreateServerWithApplicationJSONPatchPlusJSONBodyRaw = nil
+	c.stubsDestroyServer = nil
+	c.stubsDestroyServerRaw = nil
+	c.stubsGetAvailability = nil
+	c.stubsGetAvailabilityRaw = nil
+	c.stubsGetConfigurations = nil
+	c.stubsGetConfigurationsRaw = nil
+	c.stubsGetServer = nil
+	c.stubsGetServerRaw = nil
+	c.stubsGetServers = nil
+	c.stubsGetServersRaw = nil
+	c.stubsGetVirtualMachineBootLogs = nil
+	c.stubsGetVirtualMachineBootLogsRaw = nil
+	c.stubsStartServerWithBody = nil
+	c.stubsStartServerWithBodyRaw = nil
+	c.stubsStartServerWithApplicationWildcardPlusJSONBody = nil
+	c.stubsStartServerWithApplicationWildcardPlusJSONBodyRaw = nil
+	c.stubsStartServer = nil
+	c.stubsStartServerRaw = nil
+	c.stubsStartServerWithApplicationJSONPatchPlusJSONBody = nil
+	c.stubsStartServerWithApplicationJSONPatchPlusJSONBodyRaw = nil
+	c.stubsStopServerWithBody = nil
+	c.stubsStopServerWithBodyRaw = nil
+	c.stubsStopServerWithApplicationWildcardPlusJSONBody = nil
+	c.stubsStopServerWithApplicationWildcardPlusJSONBodyRaw = nil
+	c.stubsStopServer = nil
+	c.stubsStopServerRaw = nil
+	c.stubsStopServerWithApplicationJSONPatchPlusJSONBody = nil
+	c.stubsStopServerWithApplicationJSONPatchPlusJSONBodyRaw = nil
+}
+
+func (c *Client) record(method string, args ...any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = append(c.calls, Call{Method: method, Args: args})
+}
+
+// OnCreateServerWithBody registers a stub for CreateServerWithBody calls with arguments satisfying the matchers.
+func (c *Client) OnCreateServerWithBody(matchers ...Matcher) *Stub[*virtual.VirtualServerDetailsItem] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*virtual.VirtualServerDetailsItem]{matchers: matchers}
+	c.stubsCreateServerWithBody = append(c.stubsCreateServerWithBody, s)
+	return s
+}
+
+// CreateServerWithBody records the call and returns the stubbed response.
+func (c *Client) CreateServerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...virtual.RequestEditorFn) (*virtual.VirtualServerDetailsItem, error) {
+	args := []any{contentType, body}
+	c.record("CreateServerWithBody", args...)
+	if c.CreateServerWithBodyFunc != nil {
+		return c.CreateServerWithBodyFunc(ctx, contentType, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateServerWithBody, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *virtual.VirtualServerDetailsItem
+		return zero, fmt.Errorf("%w: CreateServerWithBody", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnCreateServerWithBodyRaw registers a stub for CreateServerWithBodyRaw calls with arguments satisfying the matchers.
+func (c *Client) OnCreateServerWithBodyRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsCreateServerWithBodyRaw = append(c.stubsCreateServerWithBodyRaw, s)
+	return s
+}
+
+// CreateServerWithBodyRaw records the call and returns the stubbed response.
+func (c *Client) CreateServerWithBodyRaw(ctx context.Context, contentType string, body io.Reader, reqEditors ...virtual.RequestEditorFn) (*http.Response, error) {
+	args := []any{contentType, body}
+	c.record("CreateServerWithBodyRaw", args...)
+	if c.CreateServerWithBodyRawFunc != nil {
+		return c.CreateServerWithBodyRawFunc(ctx, contentType, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateServerWithBodyRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: CreateServerWithBodyRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnCreateServerWithApplicationWildcardPlusJSONBody registers a stub for CreateServerWithApplicationWildcardPlusJSONBody calls with arguments satisfying the matchers.
+func (c *Client) OnCreateServerWithApplicationWildcardPlusJSONBody(matchers ...Matcher) *Stub[*virtual.VirtualServerDetailsItem] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*virtual.VirtualServerDetailsItem]{matchers: matchers}
+	c.stubsCreateServerWithApplicationWildcardPlusJSONBody = append(c.stubsCreateServerWithApplicationWildcardPlusJSONBody, s)
+	return s
+}
+
+// CreateServerWithApplicationWildcardPlusJSONBody records the call and returns the stubbed response.
+func (c *Client) CreateServerWithApplicationWildcardPlusJSONBody(ctx context.Context, body virtual.CreateServerApplicationWildcardPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*virtual.VirtualServerDetailsItem, error) {
+	args := []any{body}
+	c.record("CreateServerWithApplicationWildcardPlusJSONBody", args...)
+	if c.CreateServerWithApplicationWildcardPlusJSONBodyFunc != nil {
+		return c.CreateServerWithApplicationWildcardPlusJSONBodyFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateServerWithApplicationWildcardPlusJSONBody, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *virtual.VirtualServerDetailsItem
+		return zero, fmt.Errorf("%w: CreateServerWithApplicationWildcardPlusJSONBody", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnCreateServerWithApplicationWildcardPlusJSONBodyRaw registers a stub for CreateServerWithApplicationWildcardPlusJSONBodyRaw calls with arguments satisfying the matchers.
+func (c *Client) OnCreateServerWithApplicationWildcardPlusJSONBodyRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsCreateServerWithApplicationWildcardPlusJSONBodyRaw = append(c.stubsCreateServerWithApplicationWildcardPlusJSONBodyRaw, s)
+	return s
+}
+
+// CreateServerWithApplicationWildcardPlusJSONBodyRaw records the call and returns the stubbed response.
+func (c *Client) CreateServerWithApplicationWildcardPlusJSONBodyRaw(ctx context.Context, body virtual.CreateServerApplicationWildcardPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*http.Response, error) {
+	args := []any{body}
+	c.record("CreateServerWithApplicationWildcardPlusJSONBodyRaw", args...)
+	if c.CreateServerWithApplicationWildcardPlusJSONBodyRawFunc != nil {
+		return c.CreateServerWithApplicationWildcardPlusJSONBodyRawFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateServerWithApplicationWildcardPlusJSONBodyRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: CreateServerWithApplicationWildcardPlusJSONBodyRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnCreateServer registers a stub for CreateServer calls with arguments satisfying the matchers.
+func (c *Client) OnCreateServer(matchers ...Matcher) *Stub[*virtual.VirtualServerDetailsItem] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*virtual.VirtualServerDetailsItem]{matchers: matchers}
+	c.stubsCreateServer = append(c.stubsCreateServer, s)
+	return s
+}
+
+// CreateServer records the call and returns the stubbed response.
+func (c *Client) CreateServer(ctx context.Context, body virtual.CreateServerJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*virtual.VirtualServerDetailsItem, error) {
+	args := []any{body}
+	c.record("CreateServer", args...)
+	if c.CreateServerFunc != nil {
+		return c.CreateServerFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateServer, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *virtual.VirtualServerDetailsItem
+		return zero, fmt.Errorf("%w: CreateServer", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnCreateServerRaw registers a stub for CreateServerRaw calls with arguments satisfying the matchers.
+func (c *Client) OnCreateServerRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsCreateServerRaw = append(c.stubsCreateServerRaw, s)
+	return s
+}
+
+// CreateServerRaw records the call and returns the stubbed response.
+func (c *Client) CreateServerRaw(ctx context.Context, body virtual.CreateServerJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*http.Response, error) {
+	args := []any{body}
+	c.record("CreateServerRaw", args...)
+	if c.CreateServerRawFunc != nil {
+		return c.CreateServerRawFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateServerRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: CreateServerRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnCreateServerWithApplicationJSONPatchPlusJSONBody registers a stub for CreateServerWithApplicationJSONPatchPlusJSONBody calls with arguments satisfying the matchers.
+func (c *Client) OnCreateServerWithApplicationJSONPatchPlusJSONBody(matchers ...Matcher) *Stub[*virtual.VirtualServerDetailsItem] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*virtual.VirtualServerDetailsItem]{matchers: matchers}
+	c.stubsCreateServerWithApplicationJSONPatchPlusJSONBody = append(c.stubsCreateServerWithApplicationJSONPatchPlusJSONBody, s)
+	return s
+}
+
+// CreateServerWithApplicationJSONPatchPlusJSONBody records the call and returns the stubbed response.
+func (c *Client) CreateServerWithApplicationJSONPatchPlusJSONBody(ctx context.Context, body virtual.CreateServerApplicationJSONPatchPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*virtual.VirtualServerDetailsItem, error) {
+	args := []any{body}
+	c.record("CreateServerWithApplicationJSONPatchPlusJSONBody", args...)
+	if c.CreateServerWithApplicationJSONPatchPlusJSONBodyFunc != nil {
+		return c.CreateServerWithApplicationJSONPatchPlusJSONBodyFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateServerWithApplicationJSONPatchPlusJSONBody, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *virtual.VirtualServerDetailsItem
+		return zero, fmt.Errorf("%w: CreateServerWithApplicationJSONPatchPlusJSONBody", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnCreateServerWithApplicationJSONPatchPlusJSONBodyRaw registers a stub for CreateServerWithApplicationJSONPatchPlusJSONBodyRaw calls with arguments satisfying the matchers.
+func (c *Client) OnCreateServerWithApplicationJSONPatchPlusJSONBodyRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsCreateServerWithApplicationJSONPatchPlusJSONBodyRaw = append(c.stubsCreateServerWithApplicationJSONPatchPlusJSONBodyRaw, s)
+	return s
+}
+
+// CreateServerWithApplicationJSONPatchPlusJSONBodyRaw records the call and returns the stubbed response.
+func (c *Client) CreateServerWithApplicationJSONPatchPlusJSONBodyRaw(ctx context.Context, body virtual.CreateServerApplicationJSONPatchPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*http.Response, error) {
+	args := []any{body}
+	c.record("CreateServerWithApplicationJSONPatchPlusJSONBodyRaw", args...)
+	if c.CreateServerWithApplicationJSONPatchPlusJSONBodyRawFunc != nil {
+		return c.CreateServerWithApplicationJSONPatchPlusJSONBodyRawFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsCreateServerWithApplicationJSONPatchPlusJSONBodyRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: CreateServerWithApplicationJSONPatchPlusJSONBodyRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnDestroyServer registers a stub for DestroyServer calls with arguments satisfying the matchers.
+func (c *Client) OnDestroyServer(matchers ...Matcher) *Stub[*virtual.ServerCommandOutput] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*virtual.ServerCommandOutput]{matchers: matchers}
+	c.stubsDestroyServer = append(c.stubsDestroyServer, s)
+	return s
+}
+
+// DestroyServer records the call and returns the stubbed response.
+func (c *Client) DestroyServer(ctx context.Context, params *virtual.DestroyServerParams, reqEditors ...virtual.RequestEditorFn) (*virtual.ServerCommandOutput, error) {
+	args := []any{params}
+	c.record("DestroyServer", args...)
+	if c.DestroyServerFunc != nil {
+		return c.DestroyServerFunc(ctx, params, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsDestroyServer, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *virtual.ServerCommandOutput
+		return zero, fmt.Errorf("%w: DestroyServer", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnDestroyServerRaw registers a stub for DestroyServerRaw calls with arguments satisfying the matchers.
+func (c *Client) OnDestroyServerRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsDestroyServerRaw = append(c.stubsDestroyServerRaw, s)
+	return s
+}
+
+// DestroyServerRaw records the call and returns the stubbed response.
+func (c *Client) DestroyServerRaw(ctx context.Context, params *virtual.DestroyServerParams, reqEditors ...virtual.RequestEditorFn) (*http.Response, error) {
+	args := []any{params}
+	c.record("DestroyServerRaw", args...)
+	if c.DestroyServerRawFunc != nil {
+		return c.DestroyServerRawFunc(ctx, params, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsDestroyServerRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: DestroyServerRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnGetAvailability registers a stub for GetAvailability calls with arguments satisfying the matchers.
+func (c *Client) OnGetAvailability(matchers ...Matcher) *Stub[*virtual.ListResultDtoOfServerAvailability] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*virtual.ListResultDtoOfServerAvailability]{matchers: matchers}
+	c.stubsGetAvailability = append(c.stubsGetAvailability, s)
+	return s
+}
+
+// GetAvailability records the call and returns the stubbed response.
+func (c *Client) GetAvailability(ctx context.Context, params *virtual.GetAvailabilityParams, reqEditors ...virtual.RequestEditorFn) (*virtual.ListResultDtoOfServerAvailability, error) {
+	args := []any{params}
+	c.record("GetAvailability", args...)
+	if c.GetAvailabilityFunc != nil {
+		return c.GetAvailabilityFunc(ctx, params, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsGetAvailability, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *virtual.ListResultDtoOfServerAvailability
+		return zero, fmt.Errorf("%w: GetAvailability", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnGetAvailabilityRaw registers a stub for GetAvailabilityRaw calls with arguments satisfying the matchers.
+func (c *Client) OnGetAvailabilityRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsGetAvailabilityRaw = append(c.stubsGetAvailabilityRaw, s)
+	return s
+}
+
+// GetAvailabilityRaw records the call and returns the stubbed response.
+func (c *Client) GetAvailabilityRaw(ctx context.Context, params *virtual.GetAvailabilityParams, reqEditors ...virtual.RequestEditorFn) (*http.Response, error) {
+	args := []any{params}
+	c.record("GetAvailabilityRaw", args...)
+	if c.GetAvailabilityRawFunc != nil {
+		return c.GetAvailabilityRawFunc(ctx, params, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsGetAvailabilityRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: GetAvailabilityRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnGetConfigurations registers a stub for GetConfigurations calls with arguments satisfying the matchers.
+func (c *Client) OnGetConfigurations(matchers ...Matcher) *Stub[*virtual.ListResultDtoOfServerConfiguration] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*virtual.ListResultDtoOfServerConfiguration]{matchers: matchers}
+	c.stubsGetConfigurations = append(c.stubsGetConfigurations, s)
+	return s
+}
+
+// GetConfigurations records the call and returns the stubbed response.
+func (c *Client) GetConfigurations(ctx context.Context, reqEditors ...virtual.RequestEditorFn) (*virtual.ListResultDtoOfServerConfiguration, error) {
+	args := []any{}
+	c.record("GetConfigurations", args...)
+	if c.GetConfigurationsFunc != nil {
+		return c.GetConfigurationsFunc(ctx, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsGetConfigurations, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *virtual.ListResultDtoOfServerConfiguration
+		return zero, fmt.Errorf("%w: GetConfigurations", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnGetConfigurationsRaw registers a stub for GetConfigurationsRaw calls with arguments satisfying the matchers.
+func (c *Client) OnGetConfigurationsRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsGetConfigurationsRaw = append(c.stubsGetConfigurationsRaw, s)
+	return s
+}
+
+// GetConfigurationsRaw records the call and returns the stubbed response.
+func (c *Client) GetConfigurationsRaw(ctx context.Context, reqEditors ...virtual.RequestEditorFn) (*http.Response, error) {
+	args := []any{}
+	c.record("GetConfigurationsRaw", args...)
+	if c.GetConfigurationsRawFunc != nil {
+		return c.GetConfigurationsRawFunc(ctx, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsGetConfigurationsRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: GetConfigurationsRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnGetServer registers a stub for GetServer calls with arguments satisfying the matchers.
+func (c *Client) OnGetServer(matchers ...Matcher) *Stub[*virtual.VirtualServerDetailsItem] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*virtual.VirtualServerDetailsItem]{matchers: matchers}
+	c.stubsGetServer = append(c.stubsGetServer, s)
+	return s
+}
+
+// GetServer records the call and returns the stubbed response.
+func (c *Client) GetServer(ctx context.Context, params *virtual.GetServerParams, reqEditors ...virtual.RequestEditorFn) (*virtual.VirtualServerDetailsItem, error) {
+	args := []any{params}
+	c.record("GetServer", args...)
+	if c.GetServerFunc != nil {
+		return c.GetServerFunc(ctx, params, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsGetServer, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *virtual.VirtualServerDetailsItem
+		return zero, fmt.Errorf("%w: GetServer", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnGetServerRaw registers a stub for GetServerRaw calls with arguments satisfying the matchers.
+func (c *Client) OnGetServerRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsGetServerRaw = append(c.stubsGetServerRaw, s)
+	return s
+}
+
+// GetServerRaw records the call and returns the stubbed response.
+func (c *Client) GetServerRaw(ctx context.Context, params *virtual.GetServerParams, reqEditors ...virtual.RequestEditorFn) (*http.Response, error) {
+	args := []any{params}
+	c.record("GetServerRaw", args...)
+	if c.GetServerRawFunc != nil {
+		return c.GetServerRawFunc(ctx, params, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsGetServerRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: GetServerRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnGetServers registers a stub for GetServers calls with arguments satisfying the matchers.
+func (c *Client) OnGetServers(matchers ...Matcher) *Stub[*virtual.ListResultDtoOfVirtualServerDetailsItem] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*virtual.ListResultDtoOfVirtualServerDetailsItem]{matchers: matchers}
+	c.stubsGetServers = append(c.stubsGetServers, s)
+	return s
+}
+
+// GetServers records the call and returns the stubbed response.
+func (c *Client) GetServers(ctx context.Context, params *virtual.GetServersParams, reqEditors ...virtual.RequestEditorFn) (*virtual.ListResultDtoOfVirtualServerDetailsItem, error) {
+	args := []any{params}
+	c.record("GetServers", args...)
+	if c.GetServersFunc != nil {
+		return c.GetServersFunc(ctx, params, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsGetServers, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *virtual.ListResultDtoOfVirtualServerDetailsItem
+		return zero, fmt.Errorf("%w: GetServers", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnGetServersRaw registers a stub for GetServersRaw calls with arguments satisfying the matchers.
+func (c *Client) OnGetServersRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsGetServersRaw = append(c.stubsGetServersRaw, s)
+	return s
+}
+
+// GetServersRaw records the call and returns the stubbed response.
+func (c *Client) GetServersRaw(ctx context.Context, params *virtual.GetServersParams, reqEditors ...virtual.RequestEditorFn) (*http.Response, error) {
+	args := []any{params}
+	c.record("GetServersRaw", args...)
+	if c.GetServersRawFunc != nil {
+		return c.GetServersRawFunc(ctx, params, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsGetServersRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: GetServersRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnGetVirtualMachineBootLogs registers a stub for GetVirtualMachineBootLogs calls with arguments satisfying the matchers.
+func (c *Client) OnGetVirtualMachineBootLogs(matchers ...Matcher) *Stub[*virtual.ServerBootLogsOutput] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*virtual.ServerBootLogsOutput]{matchers: matchers}
+	c.stubsGetVirtualMachineBootLogs = append(c.stubsGetVirtualMachineBootLogs, s)
+	return s
+}
+
+// GetVirtualMachineBootLogs records the call and returns the stubbed response.
+func (c *Client) GetVirtualMachineBootLogs(ctx context.Context, params *virtual.GetVirtualMachineBootLogsParams, reqEditors ...virtual.RequestEditorFn) (*virtual.ServerBootLogsOutput, error) {
+	args := []any{params}
+	c.record("GetVirtualMachineBootLogs", args...)
+	if c.GetVirtualMachineBootLogsFunc != nil {
+		return c.GetVirtualMachineBootLogsFunc(ctx, params, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsGetVirtualMachineBootLogs, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *virtual.ServerBootLogsOutput
+		return zero, fmt.Errorf("%w: GetVirtualMachineBootLogs", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnGetVirtualMachineBootLogsRaw registers a stub for GetVirtualMachineBootLogsRaw calls with arguments satisfying the matchers.
+func (c *Client) OnGetVirtualMachineBootLogsRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsGetVirtualMachineBootLogsRaw = append(c.stubsGetVirtualMachineBootLogsRaw, s)
+	return s
+}
+
+// GetVirtualMachineBootLogsRaw records the call and returns the stubbed response.
+func (c *Client) GetVirtualMachineBootLogsRaw(ctx context.Context, params *virtual.GetVirtualMachineBootLogsParams, reqEditors ...virtual.RequestEditorFn) (*http.Response, error) {
+	args := []any{params}
+	c.record("GetVirtualMachineBootLogsRaw", args...)
+	if c.GetVirtualMachineBootLogsRawFunc != nil {
+		return c.GetVirtualMachineBootLogsRawFunc(ctx, params, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsGetVirtualMachineBootLogsRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: GetVirtualMachineBootLogsRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStartServerWithBody registers a stub for StartServerWithBody calls with arguments satisfying the matchers.
+func (c *Client) OnStartServerWithBody(matchers ...Matcher) *Stub[*virtual.ServerCommandOutput] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*virtual.ServerCommandOutput]{matchers: matchers}
+	c.stubsStartServerWithBody = append(c.stubsStartServerWithBody, s)
+	return s
+}
+
+// StartServerWithBody records the call and returns the stubbed response.
+func (c *Client) StartServerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...virtual.RequestEditorFn) (*virtual.ServerCommandOutput, error) {
+	args := []any{contentType, body}
+	c.record("StartServerWithBody", args...)
+	if c.StartServerWithBodyFunc != nil {
+		return c.StartServerWithBodyFunc(ctx, contentType, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStartServerWithBody, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *virtual.ServerCommandOutput
+		return zero, fmt.Errorf("%w: StartServerWithBody", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStartServerWithBodyRaw registers a stub for StartServerWithBodyRaw calls with arguments satisfying the matchers.
+func (c *Client) OnStartServerWithBodyRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsStartServerWithBodyRaw = append(c.stubsStartServerWithBodyRaw, s)
+	return s
+}
+
+// StartServerWithBodyRaw records the call and returns the stubbed response.
+func (c *Client) StartServerWithBodyRaw(ctx context.Context, contentType string, body io.Reader, reqEditors ...virtual.RequestEditorFn) (*http.Response, error) {
+	args := []any{contentType, body}
+	c.record("StartServerWithBodyRaw", args...)
+	if c.StartServerWithBodyRawFunc != nil {
+		return c.StartServerWithBodyRawFunc(ctx, contentType, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStartServerWithBodyRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: StartServerWithBodyRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStartServerWithApplicationWildcardPlusJSONBody registers a stub for StartServerWithApplicationWildcardPlusJSONBody calls with arguments satisfying the matchers.
+func (c *Client) OnStartServerWithApplicationWildcardPlusJSONBody(matchers ...Matcher) *Stub[*virtual.ServerCommandOutput] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*virtual.ServerCommandOutput]{matchers: matchers}
+	c.stubsStartServerWithApplicationWildcardPlusJSONBody = append(c.stubsStartServerWithApplicationWildcardPlusJSONBody, s)
+	return s
+}
+
+// StartServerWithApplicationWildcardPlusJSONBody records the call and returns the stubbed response.
+func (c *Client) StartServerWithApplicationWildcardPlusJSONBody(ctx context.Context, body virtual.StartServerApplicationWildcardPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*virtual.ServerCommandOutput, error) {
+	args := []any{body}
+	c.record("StartServerWithApplicationWildcardPlusJSONBody", args...)
+	if c.StartServerWithApplicationWildcardPlusJSONBodyFunc != nil {
+		return c.StartServerWithApplicationWildcardPlusJSONBodyFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStartServerWithApplicationWildcardPlusJSONBody, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *virtual.ServerCommandOutput
+		return zero, fmt.Errorf("%w: StartServerWithApplicationWildcardPlusJSONBody", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStartServerWithApplicationWildcardPlusJSONBodyRaw registers a stub for StartServerWithApplicationWildcardPlusJSONBodyRaw calls with arguments satisfying the matchers.
+func (c *Client) OnStartServerWithApplicationWildcardPlusJSONBodyRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsStartServerWithApplicationWildcardPlusJSONBodyRaw = append(c.stubsStartServerWithApplicationWildcardPlusJSONBodyRaw, s)
+	return s
+}
+
+// StartServerWithApplicationWildcardPlusJSONBodyRaw records the call and returns the stubbed response.
+func (c *Client) StartServerWithApplicationWildcardPlusJSONBodyRaw(ctx context.Context, body virtual.StartServerApplicationWildcardPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*http.Response, error) {
+	args := []any{body}
+	c.record("StartServerWithApplicationWildcardPlusJSONBodyRaw", args...)
+	if c.StartServerWithApplicationWildcardPlusJSONBodyRawFunc != nil {
+		return c.StartServerWithApplicationWildcardPlusJSONBodyRawFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStartServerWithApplicationWildcardPlusJSONBodyRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: StartServerWithApplicationWildcardPlusJSONBodyRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStartServer registers a stub for StartServer calls with arguments satisfying the matchers.
+func (c *Client) OnStartServer(matchers ...Matcher) *Stub[*virtual.ServerCommandOutput] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*virtual.ServerCommandOutput]{matchers: matchers}
+	c.stubsStartServer = append(c.stubsStartServer, s)
+	return s
+}
+
+// StartServer records the call and returns the stubbed response.
+func (c *Client) StartServer(ctx context.Context, body virtual.StartServerJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*virtual.ServerCommandOutput, error) {
+	args := []any{body}
+	c.record("StartServer", args...)
+	if c.StartServerFunc != nil {
+		return c.StartServerFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStartServer, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *virtual.ServerCommandOutput
+		return zero, fmt.Errorf("%w: StartServer", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStartServerRaw registers a stub for StartServerRaw calls with arguments satisfying the matchers.
+func (c *Client) OnStartServerRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsStartServerRaw = append(c.stubsStartServerRaw, s)
+	return s
+}
+
+// StartServerRaw records the call and returns the stubbed response.
+func (c *Client) StartServerRaw(ctx context.Context, body virtual.StartServerJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*http.Response, error) {
+	args := []any{body}
+	c.record("StartServerRaw", args...)
+	if c.StartServerRawFunc != nil {
+		return c.StartServerRawFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStartServerRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: StartServerRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStartServerWithApplicationJSONPatchPlusJSONBody registers a stub for StartServerWithApplicationJSONPatchPlusJSONBody calls with arguments satisfying the matchers.
+func (c *Client) OnStartServerWithApplicationJSONPatchPlusJSONBody(matchers ...Matcher) *Stub[*virtual.ServerCommandOutput] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*virtual.ServerCommandOutput]{matchers: matchers}
+	c.stubsStartServerWithApplicationJSONPatchPlusJSONBody = append(c.stubsStartServerWithApplicationJSONPatchPlusJSONBody, s)
+	return s
+}
+
+// StartServerWithApplicationJSONPatchPlusJSONBody records the call and returns the stubbed response.
+func (c *Client) StartServerWithApplicationJSONPatchPlusJSONBody(ctx context.Context, body virtual.StartServerApplicationJSONPatchPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*virtual.ServerCommandOutput, error) {
+	args := []any{body}
+	c.record("StartServerWithApplicationJSONPatchPlusJSONBody", args...)
+	if c.StartServerWithApplicationJSONPatchPlusJSONBodyFunc != nil {
+		return c.StartServerWithApplicationJSONPatchPlusJSONBodyFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStartServerWithApplicationJSONPatchPlusJSONBody, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *virtual.ServerCommandOutput
+		return zero, fmt.Errorf("%w: StartServerWithApplicationJSONPatchPlusJSONBody", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStartServerWithApplicationJSONPatchPlusJSONBodyRaw registers a stub for StartServerWithApplicationJSONPatchPlusJSONBodyRaw calls with arguments satisfying the matchers.
+func (c *Client) OnStartServerWithApplicationJSONPatchPlusJSONBodyRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsStartServerWithApplicationJSONPatchPlusJSONBodyRaw = append(c.stubsStartServerWithApplicationJSONPatchPlusJSONBodyRaw, s)
+	return s
+}
+
+// StartServerWithApplicationJSONPatchPlusJSONBodyRaw records the call and returns the stubbed response.
+func (c *Client) StartServerWithApplicationJSONPatchPlusJSONBodyRaw(ctx context.Context, body virtual.StartServerApplicationJSONPatchPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*http.Response, error) {
+	args := []any{body}
+	c.record("StartServerWithApplicationJSONPatchPlusJSONBodyRaw", args...)
+	if c.StartServerWithApplicationJSONPatchPlusJSONBodyRawFunc != nil {
+		return c.StartServerWithApplicationJSONPatchPlusJSONBodyRawFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStartServerWithApplicationJSONPatchPlusJSONBodyRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: StartServerWithApplicationJSONPatchPlusJSONBodyRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStopServerWithBody registers a stub for StopServerWithBody calls with arguments satisfying the matchers.
+func (c *Client) OnStopServerWithBody(matchers ...Matcher) *Stub[*virtual.ServerCommandOutput] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*virtual.ServerCommandOutput]{matchers: matchers}
+	c.stubsStopServerWithBody = append(c.stubsStopServerWithBody, s)
+	return s
+}
+
+// StopServerWithBody records the call and returns the stubbed response.
+func (c *Client) StopServerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...virtual.RequestEditorFn) (*virtual.ServerCommandOutput, error) {
+	args := []any{contentType, body}
+	c.record("StopServerWithBody", args...)
+	if c.StopServerWithBodyFunc != nil {
+		return c.StopServerWithBodyFunc(ctx, contentType, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStopServerWithBody, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *virtual.ServerCommandOutput
+		return zero, fmt.Errorf("%w: StopServerWithBody", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStopServerWithBodyRaw registers a stub for StopServerWithBodyRaw calls with arguments satisfying the matchers.
+func (c *Client) OnStopServerWithBodyRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsStopServerWithBodyRaw = append(c.stubsStopServerWithBodyRaw, s)
+	return s
+}
+
+// StopServerWithBodyRaw records the call and returns the stubbed response.
+func (c *Client) StopServerWithBodyRaw(ctx context.Context, contentType string, body io.Reader, reqEditors ...virtual.RequestEditorFn) (*http.Response, error) {
+	args := []any{contentType, body}
+	c.record("StopServerWithBodyRaw", args...)
+	if c.StopServerWithBodyRawFunc != nil {
+		return c.StopServerWithBodyRawFunc(ctx, contentType, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStopServerWithBodyRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: StopServerWithBodyRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStopServerWithApplicationWildcardPlusJSONBody registers a stub for StopServerWithApplicationWildcardPlusJSONBody calls with arguments satisfying the matchers.
+func (c *Client) OnStopServerWithApplicationWildcardPlusJSONBody(matchers ...Matcher) *Stub[*virtual.ServerCommandOutput] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*virtual.ServerCommandOutput]{matchers: matchers}
+	c.stubsStopServerWithApplicationWildcardPlusJSONBody = append(c.stubsStopServerWithApplicationWildcardPlusJSONBody, s)
+	return s
+}
+
+// StopServerWithApplicationWildcardPlusJSONBody records the call and returns the stubbed response.
+func (c *Client) StopServerWithApplicationWildcardPlusJSONBody(ctx context.Context, body virtual.StopServerApplicationWildcardPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*virtual.ServerCommandOutput, error) {
+	args := []any{body}
+	c.record("StopServerWithApplicationWildcardPlusJSONBody", args...)
+	if c.StopServerWithApplicationWildcardPlusJSONBodyFunc != nil {
+		return c.StopServerWithApplicationWildcardPlusJSONBodyFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStopServerWithApplicationWildcardPlusJSONBody, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *virtual.ServerCommandOutput
+		return zero, fmt.Errorf("%w: StopServerWithApplicationWildcardPlusJSONBody", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStopServerWithApplicationWildcardPlusJSONBodyRaw registers a stub for StopServerWithApplicationWildcardPlusJSONBodyRaw calls with arguments satisfying the matchers.
+func (c *Client) OnStopServerWithApplicationWildcardPlusJSONBodyRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsStopServerWithApplicationWildcardPlusJSONBodyRaw = append(c.stubsStopServerWithApplicationWildcardPlusJSONBodyRaw, s)
+	return s
+}
+
+// StopServerWithApplicationWildcardPlusJSONBodyRaw records the call and returns the stubbed response.
+func (c *Client) StopServerWithApplicationWildcardPlusJSONBodyRaw(ctx context.Context, body virtual.StopServerApplicationWildcardPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*http.Response, error) {
+	args := []any{body}
+	c.record("StopServerWithApplicationWildcardPlusJSONBodyRaw", args...)
+	if c.StopServerWithApplicationWildcardPlusJSONBodyRawFunc != nil {
+		return c.StopServerWithApplicationWildcardPlusJSONBodyRawFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStopServerWithApplicationWildcardPlusJSONBodyRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: StopServerWithApplicationWildcardPlusJSONBodyRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStopServer registers a stub for StopServer calls with arguments satisfying the matchers.
+func (c *Client) OnStopServer(matchers ...Matcher) *Stub[*virtual.ServerCommandOutput] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*virtual.ServerCommandOutput]{matchers: matchers}
+	c.stubsStopServer = append(c.stubsStopServer, s)
+	return s
+}
+
+// StopServer records the call and returns the stubbed response.
+func (c *Client) StopServer(ctx context.Context, body virtual.StopServerJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*virtual.ServerCommandOutput, error) {
+	args := []any{body}
+	c.record("StopServer", args...)
+	if c.StopServerFunc != nil {
+		return c.StopServerFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStopServer, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *virtual.ServerCommandOutput
+		return zero, fmt.Errorf("%w: StopServer", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStopServerRaw registers a stub for StopServerRaw calls with arguments satisfying the matchers.
+func (c *Client) OnStopServerRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsStopServerRaw = append(c.stubsStopServerRaw, s)
+	return s
+}
+
+// StopServerRaw records the call and returns the stubbed response.
+func (c *Client) StopServerRaw(ctx context.Context, body virtual.StopServerJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*http.Response, error) {
+	args := []any{body}
+	c.record("StopServerRaw", args...)
+	if c.StopServerRawFunc != nil {
+		return c.StopServerRawFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStopServerRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: StopServerRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStopServerWithApplicationJSONPatchPlusJSONBody registers a stub for StopServerWithApplicationJSONPatchPlusJSONBody calls with arguments satisfying the matchers.
+func (c *Client) OnStopServerWithApplicationJSONPatchPlusJSONBody(matchers ...Matcher) *Stub[*virtual.ServerCommandOutput] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*virtual.ServerCommandOutput]{matchers: matchers}
+	c.stubsStopServerWithApplicationJSONPatchPlusJSONBody = append(c.stubsStopServerWithApplicationJSONPatchPlusJSONBody, s)
+	return s
+}
+
+// StopServerWithApplicationJSONPatchPlusJSONBody records the call and returns the stubbed response.
+func (c *Client) StopServerWithApplicationJSONPatchPlusJSONBody(ctx context.Context, body virtual.StopServerApplicationJSONPatchPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*virtual.ServerCommandOutput, error) {
+	args := []any{body}
+	c.record("StopServerWithApplicationJSONPatchPlusJSONBody", args...)
+	if c.StopServerWithApplicationJSONPatchPlusJSONBodyFunc != nil {
+		return c.StopServerWithApplicationJSONPatchPlusJSONBodyFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStopServerWithApplicationJSONPatchPlusJSONBody, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *virtual.ServerCommandOutput
+		return zero, fmt.Errorf("%w: StopServerWithApplicationJSONPatchPlusJSONBody", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+
+// OnStopServerWithApplicationJSONPatchPlusJSONBodyRaw registers a stub for StopServerWithApplicationJSONPatchPlusJSONBodyRaw calls with arguments satisfying the matchers.
+func (c *Client) OnStopServerWithApplicationJSONPatchPlusJSONBodyRaw(matchers ...Matcher) *Stub[*http.Response] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[*http.Response]{matchers: matchers}
+	c.stubsStopServerWithApplicationJSONPatchPlusJSONBodyRaw = append(c.stubsStopServerWithApplicationJSONPatchPlusJSONBodyRaw, s)
+	return s
+}
+
+// StopServerWithApplicationJSONPatchPlusJSONBodyRaw records the call and returns the stubbed response.
+func (c *Client) StopServerWithApplicationJSONPatchPlusJSONBodyRaw(ctx context.Context, body virtual.StopServerApplicationJSONPatchPlusJSONRequestBody, reqEditors ...virtual.RequestEditorFn) (*http.Response, error) {
+	args := []any{body}
+	c.record("StopServerWithApplicationJSONPatchPlusJSONBodyRaw", args...)
+	if c.StopServerWithApplicationJSONPatchPlusJSONBodyRawFunc != nil {
+		return c.StopServerWithApplicationJSONPatchPlusJSONBodyRawFunc(ctx, body, reqEditors...)
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubsStopServerWithApplicationJSONPatchPlusJSONBodyRaw, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero *http.Response
+		return zero, fmt.Errorf("%w: StopServerWithApplicationJSONPatchPlusJSONBodyRaw", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
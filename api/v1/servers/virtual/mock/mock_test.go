@@ -0,0 +1,110 @@
+package mock_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	var client mock.Client
+
+	// Unstubbed calls fail, but are still recorded
+	_, err := client.GetServer(ctx, &virtual.GetServerParams{Id: "vm1", Namespace: "denvr", Cluster: "Msc1"})
+	assert.ErrorIs(t, err, mock.ErrNotStubbed)
+	assert.ErrorContains(t, err, "GetServer")
+
+	client.OnGetServer(mock.Eq(virtual.GetServerParams{Id: "vm1", Namespace: "denvr", Cluster: "Msc1"})).
+		Return(&virtual.VirtualServerDetailsItem{Id: ptr("vm1"), Status: ptr("PENDING")}, nil).
+		Times(1)
+	client.OnGetServer(mock.Func(func(p *virtual.GetServerParams) bool { return p.Id == "vm1" })).
+		Return(&virtual.VirtualServerDetailsItem{Id: ptr("vm1"), Status: ptr("ONLINE")}, nil)
+	client.OnGetServer(mock.Any()).Return(nil, errors.New("not found"))
+
+	params := &virtual.GetServerParams{Id: "vm1", Namespace: "denvr", Cluster: "Msc1"}
+	vm, err := client.GetServer(ctx, params)
+	require.NoError(t, err)
+	assert.Equal(t, "PENDING", *vm.Status)
+
+	vm, err = client.GetServer(ctx, params)
+	require.NoError(t, err)
+	assert.Equal(t, "ONLINE", *vm.Status)
+
+	_, err = client.GetServer(ctx, &virtual.GetServerParams{Id: "vm2"})
+	assert.EqualError(t, err, "not found")
+
+	// Stub funcs take precedence over registered stubs
+	client.GetServersFunc = func(
+		ctx context.Context,
+		params *virtual.GetServersParams,
+		reqEditors ...virtual.RequestEditorFn,
+	) (*virtual.ListResultDtoOfVirtualServerDetailsItem, error) {
+		return &virtual.ListResultDtoOfVirtualServerDetailsItem{
+			Items: &[]virtual.VirtualServerDetailsItem{{Cluster: params.Cluster}},
+		}, nil
+	}
+	client.OnGetServers().Return(nil, errors.New("unused"))
+	rsp, err := client.GetServers(ctx, &virtual.GetServersParams{Cluster: ptr("Hou1")})
+	require.NoError(t, err)
+	assert.Equal(t, "Hou1", *(*rsp.Items)[0].Cluster)
+
+	assert.Len(t, client.Calls(""), 5)
+	assert.Len(t, client.Calls("GetServer"), 4)
+	assert.Equal(t, mock.Call{Method: "GetServer", Args: []any{params}}, client.Calls("GetServer")[1])
+	assert.True(t, client.Called("GetServer", mock.Eq(&virtual.GetServerParams{Id: "vm2"})))
+	assert.False(t, client.Called("GetServer", mock.Eq(&virtual.GetServerParams{Id: "vm3"})))
+	assert.True(t, client.Called("GetServers"))
+	assert.False(t, client.Called("DestroyServer"))
+
+	client.Reset()
+	assert.Empty(t, client.Calls(""))
+	_, err = client.GetServer(ctx, params)
+	assert.ErrorIs(t, err, mock.ErrNotStubbed)
+	_, err = client.GetServers(ctx, &virtual.GetServersParams{Cluster: ptr("Hou1")})
+	assert.NoError(t, err)
+}
+
+func TestClientInterface(t *testing.T) {
+	var client virtual.ClientInterface = &mock.Client{}
+	client.(*mock.Client).OnCreateServer(mock.Func(func(body virtual.CreateServerJSONRequestBody) bool {
+		return body.Name != nil && *body.Name == "vm1"
+	})).Return(&virtual.VirtualServerDetailsItem{Id: ptr("vm1")}, nil)
+
+	vm, err := client.CreateServer(context.Background(), virtual.CreateServerJSONRequestBody{Name: ptr("vm1")})
+	require.NoError(t, err)
+	assert.Equal(t, "vm1", *vm.Id)
+
+	_, err = client.CreateServer(context.Background(), virtual.CreateServerJSONRequestBody{Name: ptr("vm2")})
+	assert.ErrorIs(t, err, mock.ErrNotStubbed)
+}
+
+func TestIterators(t *testing.T) {
+	var client mock.Client
+	client.OnGetServers().Return(
+		&virtual.ListResultDtoOfVirtualServerDetailsItem{
+			Items: &[]virtual.VirtualServerDetailsItem{{Id: ptr("vm1")}, {Id: ptr("vm2")}},
+		},
+		nil,
+	)
+
+	ids := []string{}
+	for vm, err := range virtual.AllServers(context.Background(), &client, nil) {
+		require.NoError(t, err)
+		ids = append(ids, *vm.Id)
+	}
+	assert.Equal(t, []string{"vm1", "vm2"}, ids)
+
+	for _, err := range virtual.AllConfigurations(context.Background(), &client) {
+		assert.ErrorIs(t, err, mock.ErrNotStubbed)
+	}
+}
@@ -0,0 +1,28 @@
+package virtual_test
+
+import (
+	"testing"
+
+	"github.com/denvrdata/go-denvr/api/v1/models"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetters(t *testing.T) {
+	var server *virtual.VirtualServerDetailsItem
+	assert.Equal(t, "", server.GetStatus())
+	assert.Equal(t, int32(0), server.GetGpus())
+
+	server = &virtual.VirtualServerDetailsItem{Status: virtual.Ptr("ONLINE"), Gpus: virtual.Ptr(int32(8))}
+	assert.Equal(t, "ONLINE", server.GetStatus())
+	assert.Equal(t, int32(8), server.GetGpus())
+	assert.Equal(t, "", server.GetId())
+
+	input := virtual.CreateVirtualServerInput{Cluster: "Msc1", SshKeys: []string{"ssh-ed25519 AAAA"}}
+	assert.Equal(t, "Msc1", input.GetCluster())
+	assert.Equal(t, []string{"ssh-ed25519 AAAA"}, input.GetSshKeys())
+	assert.Zero(t, input.GetRootDiskSize())
+
+	input.RootDiskSize = virtual.Ptr(models.NewGibibytes(100 * models.GiB))
+	assert.Equal(t, float64(100), input.GetRootDiskSize().In(models.GiB))
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 	"net/http"
 	"net/url"
 
@@ -16,6 +17,7 @@ import (
 
 	// "github.com/hashicorp/go-retryablehttp"
 
+	"github.com/denvrdata/go-denvr/api/v1/models"
 	"github.com/denvrdata/go-denvr/config"
 	"github.com/denvrdata/go-denvr/response"
 )
@@ -44,7 +46,7 @@ type CreateVirtualServerInput struct {
 	PersonalStorageMountPath *string `json:"personalStorageMountPath"`
 
 	// RootDiskSize Size of root disk to be created (Gi).
-	RootDiskSize *int32 `json:"rootDiskSize,omitempty"`
+	RootDiskSize *models.Gibibytes `json:"rootDiskSize,omitempty"`
 
 	// Rpool Name of the pool to be used. If not provided, first pool assigned to a tenant will be used. In case of no pool assigned, 'on-demand' will be used.
 	Rpool *string `json:"rpool"`
@@ -65,41 +67,144 @@ type CreateVirtualServerInput struct {
 	Vpc string `json:"vpc"`
 }
 
-// ListResultDtoOfServerAvailability defines model for ListResultDtoOfServerAvailability.
-type ListResultDtoOfServerAvailability struct {
-	Items *[]ServerAvailability `json:"items"`
+// GetCluster returns the Cluster field value if set, zero value otherwise.
+func (v *CreateVirtualServerInput) GetCluster() string {
+	if v == nil {
+		var zero string
+		return zero
+	}
+	return v.Cluster
 }
 
-// ListResultDtoOfServerConfiguration defines model for ListResultDtoOfServerConfiguration.
-type ListResultDtoOfServerConfiguration struct {
-	Items *[]ServerConfiguration `json:"items"`
+// GetConfiguration returns the Configuration field value if set, zero value otherwise.
+func (v *CreateVirtualServerInput) GetConfiguration() string {
+	if v == nil {
+		var zero string
+		return zero
+	}
+	return v.Configuration
 }
 
-// ListResultDtoOfVirtualServerDetailsItem defines model for ListResultDtoOfVirtualServerDetailsItem.
-type ListResultDtoOfVirtualServerDetailsItem struct {
-	Items *[]VirtualServerDetailsItem `json:"items"`
+// GetDirectStorageMountPath returns the DirectStorageMountPath field value if set, zero value otherwise.
+func (v *CreateVirtualServerInput) GetDirectStorageMountPath() string {
+	if v == nil || v.DirectStorageMountPath == nil {
+		var zero string
+		return zero
+	}
+	return *v.DirectStorageMountPath
 }
 
-// ServerAvailability defines model for ServerAvailability.
-type ServerAvailability struct {
-	Available *bool `json:"available,omitempty"`
+// GetName returns the Name field value if set, zero value otherwise.
+func (v *CreateVirtualServerInput) GetName() string {
+	if v == nil || v.Name == nil {
+		var zero string
+		return zero
+	}
+	return *v.Name
+}
 
-	// AvailableNodeNames List of node names where this configuration is available. Omitted for on-demand resource pools
-	AvailableNodeNames *[]string `json:"availableNodeNames"`
-	Cluster            *string   `json:"cluster"`
-	Configuration      *string   `json:"configuration"`
+// GetOperatingSystemImage returns the OperatingSystemImage field value if set, zero value otherwise.
+func (v *CreateVirtualServerInput) GetOperatingSystemImage() string {
+	if v == nil || v.OperatingSystemImage == nil {
+		var zero string
+		return zero
+	}
+	return *v.OperatingSystemImage
+}
 
-	// Count number of servers that can be created with this configuration.
-	Count *int32 `json:"count,omitempty"`
+// GetPersistStorage returns the PersistStorage field value if set, zero value otherwise.
+func (v *CreateVirtualServerInput) GetPersistStorage() bool {
+	if v == nil || v.PersistStorage == nil {
+		var zero bool
+		return zero
+	}
+	return *v.PersistStorage
+}
 
-	// MaxCount maximum number of servers that can be created with this configuration. 0 if resource pool is on-demand.
-	MaxCount *int32   `json:"maxCount,omitempty"`
-	Price    *float64 `json:"price,omitempty"`
-	Rpool    *string  `json:"rpool"`
-	// Deprecated:
-	Type *string `json:"type"`
+// GetPersonalStorageMountPath returns the PersonalStorageMountPath field value if set, zero value otherwise.
+func (v *CreateVirtualServerInput) GetPersonalStorageMountPath() string {
+	if v == nil || v.PersonalStorageMountPath == nil {
+		var zero string
+		return zero
+	}
+	return *v.PersonalStorageMountPath
+}
+
+// GetRootDiskSize returns the RootDiskSize field value if set, zero value otherwise.
+func (v *CreateVirtualServerInput) GetRootDiskSize() models.Gibibytes {
+	if v == nil || v.RootDiskSize == nil {
+		var zero models.Gibibytes
+		return zero
+	}
+	return *v.RootDiskSize
+}
+
+// GetRpool returns the Rpool field value if set, zero value otherwise.
+func (v *CreateVirtualServerInput) GetRpool() string {
+	if v == nil || v.Rpool == nil {
+		var zero string
+		return zero
+	}
+	return *v.Rpool
+}
+
+// GetSelectedNode returns the SelectedNode field value if set, zero value otherwise.
+func (v *CreateVirtualServerInput) GetSelectedNode() string {
+	if v == nil || v.SelectedNode == nil {
+		var zero string
+		return zero
+	}
+	return *v.SelectedNode
+}
+
+// GetSnapshotName returns the SnapshotName field value if set, zero value otherwise.
+func (v *CreateVirtualServerInput) GetSnapshotName() string {
+	if v == nil || v.SnapshotName == nil {
+		var zero string
+		return zero
+	}
+	return *v.SnapshotName
+}
+
+// GetSshKeys returns the SshKeys field value if set, zero value otherwise.
+func (v *CreateVirtualServerInput) GetSshKeys() []string {
+	if v == nil {
+		var zero []string
+		return zero
+	}
+	return v.SshKeys
+}
+
+// GetTenantSharedAdditionalStorage returns the TenantSharedAdditionalStorage field value if set, zero value otherwise.
+func (v *CreateVirtualServerInput) GetTenantSharedAdditionalStorage() string {
+	if v == nil || v.TenantSharedAdditionalStorage == nil {
+		var zero string
+		return zero
+	}
+	return *v.TenantSharedAdditionalStorage
 }
 
+// GetVpc returns the Vpc field value if set, zero value otherwise.
+func (v *CreateVirtualServerInput) GetVpc() string {
+	if v == nil {
+		var zero string
+		return zero
+	}
+	return v.Vpc
+}
+
+// ListResultDtoOfServerAvailability defines model for ListResultDtoOfServerAvailability.
+type ListResultDtoOfServerAvailability = models.ListResult[ServerAvailability]
+
+// ListResultDtoOfServerConfiguration defines model for ListResultDtoOfServerConfiguration.
+type ListResultDtoOfServerConfiguration = models.ListResult[ServerConfiguration]
+
+// ListResultDtoOfVirtualServerDetailsItem defines model for ListResultDtoOfVirtualServerDetailsItem.
+type ListResultDtoOfVirtualServerDetailsItem = models.ListResult[VirtualServerDetailsItem]
+
+// ServerAvailability defines model for ServerAvailability.
+type ServerAvailability = models.Availability
+
 // ServerBootLogsOutput defines model for ServerBootLogsOutput.
 type ServerBootLogsOutput struct {
 	BootLogs  *string `json:"bootLogs"`
@@ -108,6 +213,42 @@ type ServerBootLogsOutput struct {
 	Namespace *string `json:"namespace"`
 }
 
+// GetBootLogs returns the BootLogs field value if set, zero value otherwise.
+func (v *ServerBootLogsOutput) GetBootLogs() string {
+	if v == nil || v.BootLogs == nil {
+		var zero string
+		return zero
+	}
+	return *v.BootLogs
+}
+
+// GetCluster returns the Cluster field value if set, zero value otherwise.
+func (v *ServerBootLogsOutput) GetCluster() string {
+	if v == nil || v.Cluster == nil {
+		var zero string
+		return zero
+	}
+	return *v.Cluster
+}
+
+// GetId returns the Id field value if set, zero value otherwise.
+func (v *ServerBootLogsOutput) GetId() string {
+	if v == nil || v.Id == nil {
+		var zero string
+		return zero
+	}
+	return *v.Id
+}
+
+// GetNamespace returns the Namespace field value if set, zero value otherwise.
+func (v *ServerBootLogsOutput) GetNamespace() string {
+	if v == nil || v.Namespace == nil {
+		var zero string
+		return zero
+	}
+	return *v.Namespace
+}
+
 // ServerCommandInput defines model for ServerCommandInput.
 type ServerCommandInput struct {
 	// Cluster The cluster you're operating on
@@ -120,6 +261,33 @@ type ServerCommandInput struct {
 	Namespace string `json:"namespace"`
 }
 
+// GetCluster returns the Cluster field value if set, zero value otherwise.
+func (v *ServerCommandInput) GetCluster() string {
+	if v == nil {
+		var zero string
+		return zero
+	}
+	return v.Cluster
+}
+
+// GetId returns the Id field value if set, zero value otherwise.
+func (v *ServerCommandInput) GetId() string {
+	if v == nil {
+		var zero string
+		return zero
+	}
+	return v.Id
+}
+
+// GetNamespace returns the Namespace field value if set, zero value otherwise.
+func (v *ServerCommandInput) GetNamespace() string {
+	if v == nil {
+		var zero string
+		return zero
+	}
+	return v.Namespace
+}
+
 // ServerCommandOutput defines model for ServerCommandOutput.
 type ServerCommandOutput struct {
 	Cluster *string `json:"cluster"`
@@ -127,6 +295,33 @@ type ServerCommandOutput struct {
 	Status  *string `json:"status"`
 }
 
+// GetCluster returns the Cluster field value if set, zero value otherwise.
+func (v *ServerCommandOutput) GetCluster() string {
+	if v == nil || v.Cluster == nil {
+		var zero string
+		return zero
+	}
+	return *v.Cluster
+}
+
+// GetId returns the Id field value if set, zero value otherwise.
+func (v *ServerCommandOutput) GetId() string {
+	if v == nil || v.Id == nil {
+		var zero string
+		return zero
+	}
+	return *v.Id
+}
+
+// GetStatus returns the Status field value if set, zero value otherwise.
+func (v *ServerCommandOutput) GetStatus() string {
+	if v == nil || v.Status == nil {
+		var zero string
+		return zero
+	}
+	return *v.Status
+}
+
 // ServerConfiguration defines model for ServerConfiguration.
 type ServerConfiguration struct {
 	Brand          *string   `json:"brand"`
@@ -141,22 +336,232 @@ type ServerConfiguration struct {
 	// Deprecated:
 	GpuName *string `json:"gpu_name"`
 	// Deprecated:
-	GpuType       *string `json:"gpu_type"`
-	Gpus          *int32  `json:"gpus,omitempty"`
-	Id            *int32  `json:"id,omitempty"`
-	IsGpuPlatform *bool   `json:"is_gpu_platform,omitempty"`
-	Memory        *int64  `json:"memory,omitempty"`
-	Name          *string `json:"name"`
+	GpuType       *string           `json:"gpu_type"`
+	Gpus          *int32            `json:"gpus,omitempty"`
+	Id            *int32            `json:"id,omitempty"`
+	IsGpuPlatform *bool             `json:"is_gpu_platform,omitempty"`
+	Memory        *models.Gigabytes `json:"memory,omitempty"`
+	Name          *string           `json:"name"`
 	// Deprecated:
 	OsType *string `json:"os_type"`
 	// Deprecated:
-	OsVersion        *string  `json:"os_version"`
-	Price            *float64 `json:"price,omitempty"`
-	Storage          *int64   `json:"storage,omitempty"`
-	TextName         *string  `json:"text_name"`
-	Type             *string  `json:"type"`
-	UserFriendlyName *string  `json:"user_friendly_name"`
-	Vcpus            *int32   `json:"vcpus,omitempty"`
+	OsVersion        *string           `json:"os_version"`
+	Price            *float64          `json:"price,omitempty"`
+	Storage          *models.Gigabytes `json:"storage,omitempty"`
+	TextName         *string           `json:"text_name"`
+	Type             *string           `json:"type"`
+	UserFriendlyName *string           `json:"user_friendly_name"`
+	Vcpus            *int32            `json:"vcpus,omitempty"`
+}
+
+// GetBrand returns the Brand field value if set, zero value otherwise.
+func (v *ServerConfiguration) GetBrand() string {
+	if v == nil || v.Brand == nil {
+		var zero string
+		return zero
+	}
+	return *v.Brand
+}
+
+// GetBrandFamily returns the BrandFamily field value if set, zero value otherwise.
+func (v *ServerConfiguration) GetBrandFamily() string {
+	if v == nil || v.BrandFamily == nil {
+		var zero string
+		return zero
+	}
+	return *v.BrandFamily
+}
+
+// GetClusters returns the Clusters field value if set, zero value otherwise.
+func (v *ServerConfiguration) GetClusters() []string {
+	if v == nil || v.Clusters == nil {
+		var zero []string
+		return zero
+	}
+	return *v.Clusters
+}
+
+// GetComputeNetwork returns the ComputeNetwork field value if set, zero value otherwise.
+func (v *ServerConfiguration) GetComputeNetwork() string {
+	if v == nil || v.ComputeNetwork == nil {
+		var zero string
+		return zero
+	}
+	return *v.ComputeNetwork
+}
+
+// GetDescription returns the Description field value if set, zero value otherwise.
+func (v *ServerConfiguration) GetDescription() string {
+	if v == nil || v.Description == nil {
+		var zero string
+		return zero
+	}
+	return *v.Description
+}
+
+// GetGpuBrand returns the GpuBrand field value if set, zero value otherwise.
+//
+// Deprecated: GpuBrand is deprecated in the spec.
+func (v *ServerConfiguration) GetGpuBrand() string {
+	if v == nil || v.GpuBrand == nil {
+		var zero string
+		return zero
+	}
+	return *v.GpuBrand
+}
+
+// GetGpuFamily returns the GpuFamily field value if set, zero value otherwise.
+//
+// Deprecated: GpuFamily is deprecated in the spec.
+func (v *ServerConfiguration) GetGpuFamily() string {
+	if v == nil || v.GpuFamily == nil {
+		var zero string
+		return zero
+	}
+	return *v.GpuFamily
+}
+
+// GetGpuName returns the GpuName field value if set, zero value otherwise.
+//
+// Deprecated: GpuName is deprecated in the spec.
+func (v *ServerConfiguration) GetGpuName() string {
+	if v == nil || v.GpuName == nil {
+		var zero string
+		return zero
+	}
+	return *v.GpuName
+}
+
+// GetGpuType returns the GpuType field value if set, zero value otherwise.
+//
+// Deprecated: GpuType is deprecated in the spec.
+func (v *ServerConfiguration) GetGpuType() string {
+	if v == nil || v.GpuType == nil {
+		var zero string
+		return zero
+	}
+	return *v.GpuType
+}
+
+// GetGpus returns the Gpus field value if set, zero value otherwise.
+func (v *ServerConfiguration) GetGpus() int32 {
+	if v == nil || v.Gpus == nil {
+		var zero int32
+		return zero
+	}
+	return *v.Gpus
+}
+
+// GetId returns the Id field value if set, zero value otherwise.
+func (v *ServerConfiguration) GetId() int32 {
+	if v == nil || v.Id == nil {
+		var zero int32
+		return zero
+	}
+	return *v.Id
+}
+
+// GetIsGpuPlatform returns the IsGpuPlatform field value if set, zero value otherwise.
+func (v *ServerConfiguration) GetIsGpuPlatform() bool {
+	if v == nil || v.IsGpuPlatform == nil {
+		var zero bool
+		return zero
+	}
+	return *v.IsGpuPlatform
+}
+
+// GetMemory returns the Memory field value if set, zero value otherwise.
+func (v *ServerConfiguration) GetMemory() models.Gigabytes {
+	if v == nil || v.Memory == nil {
+		var zero models.Gigabytes
+		return zero
+	}
+	return *v.Memory
+}
+
+// GetName returns the Name field value if set, zero value otherwise.
+func (v *ServerConfiguration) GetName() string {
+	if v == nil || v.Name == nil {
+		var zero string
+		return zero
+	}
+	return *v.Name
+}
+
+// GetOsType returns the OsType field value if set, zero value otherwise.
+//
+// Deprecated: OsType is deprecated in the spec.
+func (v *ServerConfiguration) GetOsType() string {
+	if v == nil || v.OsType == nil {
+		var zero string
+		return zero
+	}
+	return *v.OsType
+}
+
+// GetOsVersion returns the OsVersion field value if set, zero value otherwise.
+//
+// Deprecated: OsVersion is deprecated in the spec.
+func (v *ServerConfiguration) GetOsVersion() string {
+	if v == nil || v.OsVersion == nil {
+		var zero string
+		return zero
+	}
+	return *v.OsVersion
+}
+
+// GetPrice returns the Price field value if set, zero value otherwise.
+func (v *ServerConfiguration) GetPrice() float64 {
+	if v == nil || v.Price == nil {
+		var zero float64
+		return zero
+	}
+	return *v.Price
+}
+
+// GetStorage returns the Storage field value if set, zero value otherwise.
+func (v *ServerConfiguration) GetStorage() models.Gigabytes {
+	if v == nil || v.Storage == nil {
+		var zero models.Gigabytes
+		return zero
+	}
+	return *v.Storage
+}
+
+// GetTextName returns the TextName field value if set, zero value otherwise.
+func (v *ServerConfiguration) GetTextName() string {
+	if v == nil || v.TextName == nil {
+		var zero string
+		return zero
+	}
+	return *v.TextName
+}
+
+// GetType returns the Type field value if set, zero value otherwise.
+func (v *ServerConfiguration) GetType() string {
+	if v == nil || v.Type == nil {
+		var zero string
+		return zero
+	}
+	return *v.Type
+}
+
+// GetUserFriendlyName returns the UserFriendlyName field value if set, zero value otherwise.
+func (v *ServerConfiguration) GetUserFriendlyName() string {
+	if v == nil || v.UserFriendlyName == nil {
+		var zero string
+		return zero
+	}
+	return *v.UserFriendlyName
+}
+
+// GetVcpus returns the Vcpus field value if set, zero value otherwise.
+func (v *ServerConfiguration) GetVcpus() int32 {
+	if v == nil || v.Vcpus == nil {
+		var zero int32
+		return zero
+	}
+	return *v.Vcpus
 }
 
 // VirtualServerDetailsItem defines model for VirtualServerDetailsItem.
@@ -181,19 +586,19 @@ type VirtualServerDetailsItem struct {
 	Image *string `json:"image"`
 
 	// Ip The public IP address of the VM
-	Ip          *string `json:"ip"`
-	LastUpdated *string `json:"lastUpdated,omitempty"`
+	Ip          *string      `json:"ip"`
+	LastUpdated *models.Time `json:"lastUpdated,omitempty"`
 
 	// Memory Amount of system memory available in GB
-	Memory    *int64  `json:"memory"`
-	Namespace *string `json:"namespace"`
+	Memory    *models.Gigabytes `json:"memory"`
+	Namespace *string           `json:"namespace"`
 
 	// NodeSelector The specific node where the VM is scheduled
 	NodeSelector *string `json:"nodeSelector"`
 
 	// PrivateIp The private IP address of the VM
-	PrivateIp    *string `json:"privateIp"`
-	RootDiskSize *string `json:"rootDiskSize"`
+	PrivateIp    *string                 `json:"privateIp"`
+	RootDiskSize *models.GibibytesString `json:"rootDiskSize"`
 
 	// Rpool Resource pool where the VM has been created
 	Rpool *string `json:"rpool"`
@@ -202,8 +607,8 @@ type VirtualServerDetailsItem struct {
 	Status *string `json:"status"`
 
 	// Storage The amount of storage attached to the VM in GB
-	Storage     *int64  `json:"storage"`
-	StorageType *string `json:"storageType"`
+	Storage     *models.Gigabytes `json:"storage"`
+	StorageType *string           `json:"storageType"`
 
 	// TenancyName Name of the tenant where the VM has been created
 	TenancyName *string `json:"tenancy_name"`
@@ -215,6 +620,200 @@ type VirtualServerDetailsItem struct {
 	Vcpus *int32 `json:"vcpus"`
 }
 
+// GetCluster returns the Cluster field value if set, zero value otherwise.
+func (v *VirtualServerDetailsItem) GetCluster() string {
+	if v == nil || v.Cluster == nil {
+		var zero string
+		return zero
+	}
+	return *v.Cluster
+}
+
+// GetConfiguration returns the Configuration field value if set, zero value otherwise.
+func (v *VirtualServerDetailsItem) GetConfiguration() string {
+	if v == nil || v.Configuration == nil {
+		var zero string
+		return zero
+	}
+	return *v.Configuration
+}
+
+// GetDirectAttachedStoragePersisted returns the DirectAttachedStoragePersisted field value if set, zero value otherwise.
+func (v *VirtualServerDetailsItem) GetDirectAttachedStoragePersisted() bool {
+	if v == nil || v.DirectAttachedStoragePersisted == nil {
+		var zero bool
+		return zero
+	}
+	return *v.DirectAttachedStoragePersisted
+}
+
+// GetGpuType returns the GpuType field value if set, zero value otherwise.
+func (v *VirtualServerDetailsItem) GetGpuType() string {
+	if v == nil || v.GpuType == nil {
+		var zero string
+		return zero
+	}
+	return *v.GpuType
+}
+
+// GetGpus returns the Gpus field value if set, zero value otherwise.
+func (v *VirtualServerDetailsItem) GetGpus() int32 {
+	if v == nil || v.Gpus == nil {
+		var zero int32
+		return zero
+	}
+	return *v.Gpus
+}
+
+// GetId returns the Id field value if set, zero value otherwise.
+func (v *VirtualServerDetailsItem) GetId() string {
+	if v == nil || v.Id == nil {
+		var zero string
+		return zero
+	}
+	return *v.Id
+}
+
+// GetImage returns the Image field value if set, zero value otherwise.
+func (v *VirtualServerDetailsItem) GetImage() string {
+	if v == nil || v.Image == nil {
+		var zero string
+		return zero
+	}
+	return *v.Image
+}
+
+// GetIp returns the Ip field value if set, zero value otherwise.
+func (v *VirtualServerDetailsItem) GetIp() string {
+	if v == nil || v.Ip == nil {
+		var zero string
+		return zero
+	}
+	return *v.Ip
+}
+
+// GetLastUpdated returns the LastUpdated field value if set, zero value otherwise.
+func (v *VirtualServerDetailsItem) GetLastUpdated() models.Time {
+	if v == nil || v.LastUpdated == nil {
+		var zero models.Time
+		return zero
+	}
+	return *v.LastUpdated
+}
+
+// GetMemory returns the Memory field value if set, zero value otherwise.
+func (v *VirtualServerDetailsItem) GetMemory() models.Gigabytes {
+	if v == nil || v.Memory == nil {
+		var zero models.Gigabytes
+		return zero
+	}
+	return *v.Memory
+}
+
+// GetNamespace returns the Namespace field value if set, zero value otherwise.
+func (v *VirtualServerDetailsItem) GetNamespace() string {
+	if v == nil || v.Namespace == nil {
+		var zero string
+		return zero
+	}
+	return *v.Namespace
+}
+
+// GetNodeSelector returns the NodeSelector field value if set, zero value otherwise.
+func (v *VirtualServerDetailsItem) GetNodeSelector() string {
+	if v == nil || v.NodeSelector == nil {
+		var zero string
+		return zero
+	}
+	return *v.NodeSelector
+}
+
+// GetPrivateIp returns the PrivateIp field value if set, zero value otherwise.
+func (v *VirtualServerDetailsItem) GetPrivateIp() string {
+	if v == nil || v.PrivateIp == nil {
+		var zero string
+		return zero
+	}
+	return *v.PrivateIp
+}
+
+// GetRootDiskSize returns the RootDiskSize field value if set, zero value otherwise.
+func (v *VirtualServerDetailsItem) GetRootDiskSize() models.GibibytesString {
+	if v == nil || v.RootDiskSize == nil {
+		var zero models.GibibytesString
+		return zero
+	}
+	return *v.RootDiskSize
+}
+
+// GetRpool returns the Rpool field value if set, zero value otherwise.
+func (v *VirtualServerDetailsItem) GetRpool() string {
+	if v == nil || v.Rpool == nil {
+		var zero string
+		return zero
+	}
+	return *v.Rpool
+}
+
+// GetStatus returns the Status field value if set, zero value otherwise.
+func (v *VirtualServerDetailsItem) GetStatus() string {
+	if v == nil || v.Status == nil {
+		var zero string
+		return zero
+	}
+	return *v.Status
+}
+
+// GetStorage returns the Storage field value if set, zero value otherwise.
+func (v *VirtualServerDetailsItem) GetStorage() models.Gigabytes {
+	if v == nil || v.Storage == nil {
+		var zero models.Gigabytes
+		return zero
+	}
+	return *v.Storage
+}
+
+// GetStorageType returns the StorageType field value if set, zero value otherwise.
+func (v *VirtualServerDetailsItem) GetStorageType() string {
+	if v == nil || v.StorageType == nil {
+		var zero string
+		return zero
+	}
+	return *v.StorageType
+}
+
+// GetTenancyName returns the TenancyName field value if set, zero value otherwise.
+func (v *VirtualServerDetailsItem) GetTenancyName() string {
+	if v == nil || v.TenancyName == nil {
+		var zero string
+		return zero
+	}
+	return *v.TenancyName
+}
+
+// GetUsername returns the Username field value if set, zero value otherwise.
+func (v *VirtualServerDetailsItem) GetUsername() string {
+	if v == nil || v.Username == nil {
+		var zero string
+		return zero
+	}
+	return *v.Username
+}
+
+// GetVcpus returns the Vcpus field value if set, zero value otherwise.
+func (v *VirtualServerDetailsItem) GetVcpus() int32 {
+	if v == nil || v.Vcpus == nil {
+		var zero int32
+		return zero
+	}
+	return *v.Vcpus
+}
+
+// Ptr returns a pointer to v, for setting the optional fields of the models (e.g., Ptr("my-vm")).
+func Ptr[T any](v T) *T {
+	return &v
+}
+
 // DestroyServerParams defines parameters for DestroyServer.
 type DestroyServerParams struct {
 	// DeleteSnapshots Should also delete snapshots with virtual machine.
@@ -231,13 +830,8 @@ type DestroyServerParams struct {
 }
 
 // GetAvailabilityParams defines parameters for GetAvailability.
-type GetAvailabilityParams struct {
-	Cluster      string  `form:"cluster" json:"cluster"`
-	ResourcePool *string `form:"resourcePool,omitempty" json:"resourcePool,omitempty"`
-
-	// ReportNodes controls if Count and MaxCount is calculated and returned in the response. If they are not needed, use 'false' to improve response time of the endpoint.
-	ReportNodes *bool `form:"reportNodes,omitempty" json:"reportNodes,omitempty"`
-}
+// The parameters are shared with the other services (see the models section of api/v1/services.yaml).
+type GetAvailabilityParams = models.AvailabilityParams
 
 // GetServerParams defines parameters for GetServer.
 type GetServerParams struct {
@@ -1343,4 +1937,58 @@ func (c *Client) applyEditors(ctx context.Context, req *http.Request, additional
 	return nil
 }
 
+// AllAvailability returns an iterator over the items returned by GetAvailability, which works with any ClientInterface
+// (e.g., mocks). The API returns every item in a single response, so the iterator makes a single request.
+func AllAvailability(ctx context.Context, c ClientInterface, params *GetAvailabilityParams, reqEditors ...RequestEditorFn) iter.Seq2[ServerAvailability, error] {
+	return func(yield func(ServerAvailability, error) bool) {
+		rsp, err := c.GetAvailability(ctx, params, reqEditors...)
+		if err != nil {
+			var zero ServerAvailability
+			yield(zero, err)
+			return
+		}
+		for _, item := range rsp.GetItems() {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// AllConfigurations returns an iterator over the items returned by GetConfigurations, which works with any ClientInterface
+// (e.g., mocks). The API returns every item in a single response, so the iterator makes a single request.
+func AllConfigurations(ctx context.Context, c ClientInterface, reqEditors ...RequestEditorFn) iter.Seq2[ServerConfiguration, error] {
+	return func(yield func(ServerConfiguration, error) bool) {
+		rsp, err := c.GetConfigurations(ctx, reqEditors...)
+		if err != nil {
+			var zero ServerConfiguration
+			yield(zero, err)
+			return
+		}
+		for _, item := range rsp.GetItems() {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// AllServers returns an iterator over the items returned by GetServers, which works with any ClientInterface
+// (e.g., mocks). The API returns every item in a single response, so the iterator makes a single request.
+func AllServers(ctx context.Context, c ClientInterface, params *GetServersParams, reqEditors ...RequestEditorFn) iter.Seq2[VirtualServerDetailsItem, error] {
+	return func(yield func(VirtualServerDetailsItem, error) bool) {
+		rsp, err := c.GetServers(ctx, params, reqEditors...)
+		if err != nil {
+			var zero VirtualServerDetailsItem
+			yield(zero, err)
+			return
+		}
+		for _, item := range rsp.GetItems() {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
 // Leaving client-with-responses file blank since we don't need it
@@ -0,0 +1,18 @@
+// Package v1 embeds the filtered OpenAPI spec which the v1 service clients are generated from.
+package v1
+
+import (
+	_ "embed"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Spec is the api.json written by tools/specfilter from services.yaml.
+//
+//go:embed api.json
+var Spec []byte
+
+// Load parses the embedded spec.
+func Load() (*openapi3.T, error) {
+	return openapi3.NewLoader().LoadFromData(Spec)
+}
@@ -0,0 +1,20 @@
+package v1_test
+
+import (
+	"testing"
+
+	v1 "github.com/denvrdata/go-denvr/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	doc, err := v1.Load()
+	require.NoError(t, err)
+
+	op := doc.Paths.Find("/api/v1/servers/virtual/GetServer").Get
+	require.NotNil(t, op)
+	assert.Equal(t, "GetServer", op.OperationID)
+	assert.Equal(t, []string{"servers/virtual"}, op.Tags)
+	assert.Contains(t, doc.Components.Schemas, "ApplicationsApiOverview")
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/config"
+	"github.com/denvrdata/go-denvr/logs"
+)
+
+// logsCmd prints the boot logs of a server or the runtime logs of an application.
+//
+//	denvr logs [-f] [-cluster Msc1] server|application <name>
+//	denvr logs -grep nvidia -level error,fatal -o boot.jsonl server <name>
+func logsCmd(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("logs", flag.ExitOnError)
+	follow := fs.Bool("f", false, "keep printing new lines until the server is ONLINE or the application stops")
+	cluster := fs.String("cluster", "", "cluster of the resource (defaults to the config cluster)")
+	namespace := fs.String("namespace", "", "namespace/vpc of the server (defaults to the config vpcid)")
+	limit := fs.Int("limit", 2000, "maximum number of lines to request")
+	interval := fs.Duration("interval", 5*time.Second, "how often to poll for new lines with -f")
+	grep := fs.String("grep", "", "only print records matching this regular expression")
+	level := fs.String("level", "", "only print records with these comma separated levels (e.g., warn,error)")
+	since := fs.String("since", "", "only print records at or after this RFC3339 time")
+	until := fs.String("until", "", "only print records at or before this RFC3339 time")
+	output := fs.String("o", "", "write the matching records to this file (JSON Lines if it ends in .jsonl)")
+	fs.Parse(args)
+
+	if fs.NArg() != 2 {
+		return errors.New("usage: denvr logs [-f] [flags] server|application <name>")
+	}
+	kind, name := fs.Arg(0), fs.Arg(1)
+
+	conf := config.NewConfig()
+	if *cluster == "" {
+		*cluster = conf.Cluster
+	}
+	if *namespace == "" {
+		*namespace = conf.VPCId
+	}
+
+	var f *logs.Follower
+	switch kind {
+	case "server":
+		client := virtual.NewClient()
+		f = logs.ServerFollower(
+			&client,
+			virtual.GetVirtualMachineBootLogsParams{Id: name, Namespace: *namespace, Cluster: *cluster, Limit: int32(*limit)},
+		)
+	case "application":
+		client := applications.NewClient()
+		f = logs.ApplicationFollower(
+			&client,
+			applications.GetApplicationRuntimeLogsParams{Id: name, Cluster: *cluster, Limit: int32(*limit)},
+		)
+	default:
+		return fmt.Errorf("unknown resource kind %q, expected server or application", kind)
+	}
+	f.Interval = *interval
+
+	var filter logs.Filter
+	var err error
+	if *grep != "" {
+		if filter.Pattern, err = regexp.Compile(*grep); err != nil {
+			return err
+		}
+	}
+	if *level != "" {
+		filter.Levels = strings.Split(*level, ",")
+	}
+	if *since != "" {
+		if filter.Since, err = time.Parse(time.RFC3339, *since); err != nil {
+			return err
+		}
+	}
+	if *until != "" {
+		if filter.Until, err = time.Parse(time.RFC3339, *until); err != nil {
+			return err
+		}
+	}
+
+	if !*follow {
+		text, _, err := f.Source(ctx)
+		if err != nil {
+			return err
+		}
+		records := filter.Apply(logs.Parse(text))
+		if *output != "" {
+			return logs.Export(*output, records)
+		}
+		return logs.WriteText(os.Stdout, records)
+	}
+	if *output != "" {
+		return errors.New("-o can't be combined with -f")
+	}
+	if filter.Pattern != nil || filter.Levels != nil || !filter.Since.IsZero() || !filter.Until.IsZero() {
+		// Records are filtered line by line while following, so continuation lines are matched on their own.
+		for line, err := range f.Lines(ctx) {
+			if err != nil {
+				return err
+			}
+			if records := filter.Apply(logs.Parse(line)); len(records) > 0 {
+				logs.WriteText(os.Stdout, records)
+			}
+		}
+		return nil
+	}
+
+	r := f.Follow(ctx)
+	defer r.Close()
+	_, err = io.Copy(os.Stdout, r)
+	return err
+}
@@ -0,0 +1,70 @@
+// Command denvr provides a few operational helpers on top of the go-denvr packages.
+// Credentials and defaults are loaded from the same config file and environment variables as the clients.
+//
+// Usage:
+//
+//	denvr <command> [flags]
+package main
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"os/signal"
+	"slices"
+
+	// Supports the validate setting of the config file
+	_ "github.com/denvrdata/go-denvr/validator"
+)
+
+// commands maps each subcommand name to its entrypoint, which receives the remaining arguments.
+var commands = map[string]func(ctx context.Context, args []string) error{
+	"logs":       logsCmd,
+	"operations": operations,
+	"reap":       reap,
+	"schedule":   scheduler,
+	"ssh-config": sshConfig,
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	cmd, ok := commands[os.Args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n", os.Args[1])
+		usage()
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := cmd(ctx, os.Args[2:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: denvr <command> [flags]")
+	fmt.Fprintln(os.Stderr, "commands:")
+	for _, name := range slices.Sorted(maps.Keys(commands)) {
+		fmt.Fprintf(os.Stderr, "  %s\n", name)
+	}
+}
+
+// list is a repeatable string flag (e.g., `-owner alice -owner bob`).
+type list []string
+
+func (l *list) String() string {
+	return fmt.Sprint(*l)
+}
+
+func (l *list) Set(s string) error {
+	*l = append(*l, s)
+	return nil
+}
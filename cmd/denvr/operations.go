@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/denvrdata/go-denvr/api/v1/registry"
+)
+
+// operations lists the API operations in the embedded spec, without needing credentials.
+//
+//	denvr operations -tag servers/virtual
+//	denvr operations -v GetServer
+func operations(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("operations", flag.ExitOnError)
+	tag := fs.String("tag", "", "only list operations with this tag (e.g., servers/applications)")
+	verbose := fs.Bool("v", false, "also list the parameters and Go types of each operation")
+	fs.Parse(args)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "TAG\tOPERATION\tMETHOD\tPATH")
+	for _, op := range registry.Operations {
+		if *tag != "" && op.Tag != *tag {
+			continue
+		}
+		if fs.NArg() > 0 && !strings.EqualFold(fs.Arg(0), op.ID) {
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", op.Tag, op.ID, op.Method, op.Path)
+		if !*verbose {
+			continue
+		}
+		for _, p := range op.Params {
+			required := ""
+			if p.Required {
+				required = " (required)"
+			}
+			fmt.Fprintf(w, "\t  %s %s%s: %s\n", p.Name, p.Type, required, p.Description)
+		}
+		if op.Request != nil {
+			fmt.Fprintf(w, "\t  request: %s\n", op.Request)
+		}
+		if op.Response != nil {
+			fmt.Fprintf(w, "\t  response: %s\n", op.Response)
+		}
+	}
+	return w.Flush()
+}
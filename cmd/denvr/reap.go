@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/reaper"
+)
+
+// reap stops or destroys idle servers and applications. It is a dry run unless -apply is provided.
+func reap(ctx context.Context, args []string) error {
+	var rules reaper.Rules
+	var owners, names, allow list
+	fs := flag.NewFlagSet("reap", flag.ExitOnError)
+	fs.DurationVar(&rules.MaxAge, "max-age", 24*time.Hour, "flag resources which have been ONLINE for longer than this")
+	fs.DurationVar(&rules.GracePeriod, "grace", time.Hour, "how long flagged resources are left before they're reaped")
+	fs.StringVar(&rules.KeepLabel, "keep-label", "keep", "name label which exempts a resource")
+	fs.Var(&owners, "owner", "only reap resources created by this user (repeatable)")
+	fs.Var(&names, "name", "only reap resources with names matching this glob (repeatable)")
+	fs.Var(&allow, "allow", "never reap names or cluster/names matching this glob (repeatable)")
+	action := fs.String("action", "stop", "action to take on due resources (stop or destroy)")
+	apply := fs.Bool("apply", false, "actually stop or destroy resources rather than reporting them")
+	servers := fs.Bool("servers", true, "include virtual servers")
+	apps := fs.Bool("applications", true, "include applications")
+	fs.Parse(args)
+
+	rules.Owners, rules.Names, rules.Allowlist = owners, names, allow
+	r := reaper.Reaper{
+		Rules:  rules,
+		Action: reaper.Action(*action),
+		DryRun: !*apply,
+		OnEvent: func(e reaper.Event) {
+			prefix := ""
+			if e.DryRun {
+				prefix = "[dry-run] "
+			}
+			switch e.Type {
+			case reaper.Flagged:
+				fmt.Printf(
+					"%s%s: %s for %s, will %s after %s\n",
+					prefix,
+					e.Candidate,
+					e.Candidate.Status,
+					e.Candidate.Age.Round(time.Minute),
+					e.Action,
+					e.Candidate.ReapAt.Format(time.RFC3339),
+				)
+			case reaper.Reaped:
+				fmt.Printf("%s%s: %s for %s, %s\n", prefix, e.Candidate, e.Candidate.Status, e.Candidate.Age.Round(time.Minute), e.Action)
+			case reaper.Failed, reaper.Skipped:
+				fmt.Fprintf(os.Stderr, "%s%v\n", prefix, e.Err)
+			}
+		},
+	}
+	if r.Action != reaper.Stop && r.Action != reaper.Destroy {
+		return fmt.Errorf("unknown action %q", *action)
+	}
+	if *servers {
+		client := virtual.NewClient()
+		r.Servers = &client
+	}
+	if *apps {
+		client := applications.NewClient()
+		r.Applications = &client
+	}
+
+	_, err := r.Reap(ctx)
+	return err
+}
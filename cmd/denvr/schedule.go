@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/schedule"
+)
+
+// scheduler runs the start/stop rules from a TOML file until interrupted.
+//
+// [[rule]]
+// name = "evening"
+// cron = "0 19 * * 1-5"
+// timezone = "America/Toronto"
+// action = "stop"
+// match = "dev-*"
+func scheduler(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("schedule", flag.ExitOnError)
+	rules := fs.String("rules", "schedule.toml", "TOML file containing [[rule]] entries")
+	state := fs.String("state", "schedule.state.json", "file used to persist when each rule last ran")
+	interval := fs.Duration("interval", time.Minute, "how often the rules are checked")
+	fs.Parse(args)
+
+	var content struct {
+		Rule []schedule.Rule `toml:"rule"`
+	}
+	if _, err := toml.DecodeFile(*rules, &content); err != nil {
+		return err
+	}
+
+	vc, ac := virtual.NewClient(), applications.NewClient()
+	s := schedule.Scheduler{
+		Servers:      &vc,
+		Applications: &ac,
+		Rules:        content.Rule,
+		Store:        schedule.FileStore(*state),
+		Interval:     *interval,
+		OnReport: func(r schedule.Report) {
+			if r.Err != nil {
+				fmt.Fprintln(os.Stderr, r)
+			} else {
+				fmt.Println(r)
+			}
+		},
+	}
+
+	err := s.Run(ctx)
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/ssh"
+)
+
+// sshConfig prints ~/.ssh/config entries for every server and application.
+//
+//	denvr ssh-config -identity ~/.ssh/id_ed25519 >> ~/.ssh/config
+func sshConfig(ctx context.Context, args []string) error {
+	var opts ssh.ConfigOptions
+	fs := flag.NewFlagSet("ssh-config", flag.ExitOnError)
+	fs.StringVar(&opts.Prefix, "prefix", "denvr-", "prefix for each Host alias")
+	fs.StringVar(&opts.IdentityFile, "identity", "", "IdentityFile to add to each entry")
+	fs.BoolVar(&opts.Private, "private", false, "use private addresses")
+	fs.StringVar(&opts.ProxyJump, "proxy-jump", "", "ProxyJump host to add to each entry")
+	fs.StringVar(&opts.User, "user", "", "login user for servers whose image has no known default user")
+	fs.Parse(args)
+
+	vc, ac := virtual.NewClient(), applications.NewClient()
+	targets, err := ssh.Fleet(ctx, &vc, &ac)
+	if err != nil {
+		return err
+	}
+	fmt.Print(ssh.Config(targets, opts))
+	return nil
+}
@@ -14,6 +14,20 @@ import (
 	"github.com/hashicorp/go-retryablehttp"
 )
 
+// envValidate overrides the validate setting (see the validator package).
+const envValidate = "DENVR_VALIDATE"
+
+// validate wraps the API client to check requests and responses against the spec.
+// It's only set once the validator package is imported, so other clients don't link the spec and its dependencies.
+var validate func(client *http.Client, mode string) (*http.Client, error)
+
+// RegisterValidator enables the validate setting, which is called by the validator package when it's imported:
+//
+//	import _ "github.com/denvrdata/go-denvr/validator"
+func RegisterValidator(wrap func(client *http.Client, mode string) (*http.Client, error)) {
+	validate = wrap
+}
+
 type Config struct {
 	Auth    auth.Auth
 	Server  string
@@ -25,7 +39,9 @@ type Config struct {
 	Client  *http.Client
 }
 
-func NewConfig(paths ...string) Config {
+// Path resolves the config file location from an explicit path, the DENVR_CONFIG environment variable
+// or the default ~/.config/denvr.toml, in that order.
+func Path(paths ...string) string {
 	// Default config file location as our fallback
 	path := filepath.Join(
 		result.Wrap(os.UserHomeDir()).Unwrap(), ".config", "denvr.toml",
@@ -42,17 +58,24 @@ func NewConfig(paths ...string) Config {
 		path = os.Getenv("DENVR_CONFIG")
 	}
 
+	return path
+}
+
+func NewConfig(paths ...string) Config {
+	path := Path(paths...)
+
 	var content map[string]any
 	result.Wrap(toml.DecodeFile(path, &content)).Unwrap()
 
 	defaults := struct {
-		Server  string
-		API     string
-		Cluster string
-		Tenant  string
-		VPCId   string
-		RPool   string
-		Retries int64
+		Server   string
+		API      string
+		Cluster  string
+		Tenant   string
+		VPCId    string
+		RPool    string
+		Retries  int64
+		Validate string
 	}{
 		Server:  "https://api.cloud.denvrdata.com/",
 		API:     "v1",
@@ -89,6 +112,9 @@ func NewConfig(paths ...string) Config {
 		if retries, ok := def["retries"].(int64); ok {
 			defaults.Retries = retries
 		}
+		if validate, ok := def["validate"].(string); ok {
+			defaults.Validate = validate
+		}
 	}
 
 	// Create a retryable HTTP client for use both in our auth code and the API client code.
@@ -99,6 +125,17 @@ func NewConfig(paths ...string) Config {
 	client.Backoff = retryablehttp.DefaultBackoff
 	client.HTTPClient.Timeout = 60 * time.Second
 
+	// Optionally check API requests and responses against the spec (e.g., while debugging)
+	apiClient := client.StandardClient()
+	if env, ok := os.LookupEnv(envValidate); ok {
+		defaults.Validate = env
+	}
+	if validate != nil {
+		apiClient = result.Wrap(validate(apiClient, defaults.Validate)).Unwrap()
+	} else if defaults.Validate != "" {
+		panic(fmt.Sprintf(`validate = %q requires importing the validator package (import _ "github.com/denvrdata/go-denvr/validator")`, defaults.Validate))
+	}
+
 	return Config{
 		auth.NewAuth(path, content, defaults.Server, client.StandardClient()),
 		defaults.Server,
@@ -107,6 +144,6 @@ func NewConfig(paths ...string) Config {
 		defaults.Tenant,
 		defaults.VPCId,
 		defaults.RPool,
-		client.StandardClient(),
+		apiClient,
 	}
 }
@@ -5,12 +5,16 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"os/exec"
+	"strings"
 	"testing"
 
 	"github.com/denvrdata/go-denvr/auth"
 	"github.com/denvrdata/go-denvr/config"
 	"github.com/denvrdata/go-denvr/result"
+	"github.com/denvrdata/go-denvr/validator"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestConfigNoCredentials(t *testing.T) {
@@ -208,3 +212,58 @@ func TestBearerConfig(t *testing.T) {
 		},
 	)
 }
+
+// TestDependencies checks the clients only link the validator and its spec when it's imported.
+func TestDependencies(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go isn't installed")
+	}
+	out, err := exec.Command("go", "list", "-deps", "github.com/denvrdata/go-denvr/api/v1/servers/virtual").CombinedOutput()
+	require.NoError(t, err, string(out))
+	deps := strings.Fields(string(out))
+	assert.NotContains(t, deps, "github.com/getkin/kin-openapi/openapi3")
+	assert.NotContains(t, deps, "github.com/denvrdata/go-denvr/validator")
+	// The embedded spec
+	assert.NotContains(t, deps, "github.com/denvrdata/go-denvr/api/v1")
+}
+
+func TestConfigWithValidation(t *testing.T) {
+	content := `[defaults]
+        server = "http://localhost:8080"
+        tenant = "denvr"
+        validate = "log"
+
+        [credentials]
+        apikey = "foo.bar.baz"`
+
+	f := result.Wrap(os.CreateTemp("", "test-newconfig-tmpfile-")).Unwrap()
+	defer f.Close()
+	defer os.Remove(f.Name())
+	result.Wrap(f.Write([]byte(content))).Unwrap()
+
+	t.Run(
+		"ConfigFile",
+		func(t *testing.T) {
+			v, ok := config.NewConfig(f.Name()).Client.Transport.(*validator.Validator)
+			assert.True(t, ok)
+			assert.Equal(t, validator.Log, v.Mode)
+		},
+	)
+	t.Run(
+		"EnvOverride",
+		func(t *testing.T) {
+			t.Setenv("DENVR_VALIDATE", "fail")
+			v, ok := config.NewConfig(f.Name()).Client.Transport.(*validator.Validator)
+			assert.True(t, ok)
+			assert.Equal(t, validator.Fail, v.Mode)
+		},
+	)
+	t.Run(
+		"EnvDisabled",
+		func(t *testing.T) {
+			t.Setenv("DENVR_VALIDATE", "")
+			_, ok := config.NewConfig(f.Name()).Client.Transport.(*validator.Validator)
+			assert.False(t, ok)
+		},
+	)
+}
@@ -0,0 +1,319 @@
+// Package cost estimates the hourly cost of planned and running servers and applications
+// from the configuration and availability pricing returned by the API.
+package cost
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+)
+
+type Kind string
+
+const (
+	Server      Kind = "server"
+	Application Kind = "application"
+)
+
+// Estimate is the hourly price of a single resource.
+type Estimate struct {
+	Kind          Kind
+	Name          string
+	Cluster       string
+	Rpool         string
+	Configuration string
+	User          string
+	Status        string
+	Hourly        float64
+}
+
+// Projected returns the cost of running the resource for the given duration.
+func (e Estimate) Projected(d time.Duration) float64 {
+	return e.Hourly * d.Hours()
+}
+
+// ErrNoPrice is returned when neither the availability nor the configuration list has a price for a resource.
+var ErrNoPrice = errors.New("no price found")
+
+// Fleet is the running cost of all servers and applications, broken down by cluster, rpool and user.
+type Fleet struct {
+	Items     []Estimate
+	Hourly    float64
+	ByCluster map[string]float64
+	ByRpool   map[string]float64
+	ByUser    map[string]float64
+	// Unpriced are the running resources without a price (e.g., retired configurations), which aren't in the totals
+	Unpriced []Unpriced
+}
+
+// Unpriced is a running resource which couldn't be priced, along with the reason.
+type Unpriced struct {
+	Estimate
+	Err error
+}
+
+// Projected returns the cost of running the entire fleet for the given duration.
+func (f Fleet) Projected(d time.Duration) float64 {
+	return f.Hourly * d.Hours()
+}
+
+// price adds a priced resource to the totals, or to Unpriced if there's no price for it.
+// Other errors (e.g., failing to fetch the prices) are returned.
+func (f *Fleet) price(e Estimate, err error) error {
+	if errors.Is(err, ErrNoPrice) {
+		f.Unpriced = append(f.Unpriced, Unpriced{e, err})
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	f.add(e)
+	return nil
+}
+
+func (f *Fleet) add(e Estimate) {
+	f.Items = append(f.Items, e)
+	f.Hourly += e.Hourly
+	f.ByCluster[e.Cluster] += e.Hourly
+	f.ByRpool[e.Rpool] += e.Hourly
+	f.ByUser[e.User] += e.Hourly
+}
+
+// Estimator looks up pricing using the provided clients. Either client may be nil if not needed.
+// Configurations and availability pricing are cached for the lifetime of the Estimator.
+type Estimator struct {
+	Servers      virtual.ClientInterface
+	Applications applications.ClientInterface
+
+	mu                 sync.Mutex
+	serverPrices       map[string]float64
+	applicationPrices  map[string]float64
+	serverAvailability map[string]map[string]float64
+	appAvailability    map[string]map[string]float64
+}
+
+// Server estimates the hourly cost of a planned CreateServer call.
+func (e *Estimator) Server(ctx context.Context, input virtual.CreateVirtualServerInput) (Estimate, error) {
+	est := Estimate{
+		Kind:          Server,
+		Name:          input.GetName(),
+		Cluster:       input.Cluster,
+		Rpool:         input.GetRpool(),
+		Configuration: input.Configuration,
+	}
+	var err error
+	est.Hourly, err = e.serverPrice(ctx, est.Cluster, est.Rpool, est.Configuration)
+	return est, err
+}
+
+// CatalogApplication estimates the hourly cost of a planned CreateCatalogApplication call.
+func (e *Estimator) CatalogApplication(ctx context.Context, req applications.ApplicationsApiCreateRequest) (Estimate, error) {
+	est := Estimate{
+		Kind:          Application,
+		Name:          req.Name,
+		Cluster:       req.Cluster,
+		Rpool:         req.GetResourcePool(),
+		Configuration: req.HardwarePackageName,
+	}
+	var err error
+	est.Hourly, err = e.applicationPrice(ctx, est.Cluster, est.Rpool, est.Configuration)
+	return est, err
+}
+
+// CustomApplication estimates the hourly cost of a planned CreateCustomApplication call.
+func (e *Estimator) CustomApplication(ctx context.Context, req applications.ApplicationsApiCustomApiCreateRequest) (Estimate, error) {
+	est := Estimate{
+		Kind:          Application,
+		Name:          req.Name,
+		Cluster:       req.Cluster,
+		Rpool:         req.GetResourcePool(),
+		Configuration: req.HardwarePackageName,
+	}
+	var err error
+	est.Hourly, err = e.applicationPrice(ctx, est.Cluster, est.Rpool, est.Configuration)
+	return est, err
+}
+
+// Fleet totals the hourly cost of every running (see BilledStatuses) server and application.
+// Resources without a price are reported in Fleet.Unpriced rather than failing the whole fleet.
+func (e *Estimator) Fleet(ctx context.Context) (Fleet, error) {
+	fleet := Fleet{ByCluster: map[string]float64{}, ByRpool: map[string]float64{}, ByUser: map[string]float64{}}
+
+	if e.Servers != nil {
+		rsp, err := e.Servers.GetServers(ctx, &virtual.GetServersParams{})
+		if err != nil {
+			return fleet, err
+		}
+		for _, vm := range rsp.GetItems() {
+			if !Running(vm.GetStatus()) {
+				continue
+			}
+			est := Estimate{
+				Kind:          Server,
+				Name:          vm.GetId(),
+				Cluster:       vm.GetCluster(),
+				Rpool:         vm.GetRpool(),
+				Configuration: vm.GetConfiguration(),
+				User:          vm.GetUsername(),
+				Status:        vm.GetStatus(),
+			}
+			est.Hourly, err = e.serverPrice(ctx, est.Cluster, est.Rpool, est.Configuration)
+			if err := fleet.price(est, err); err != nil {
+				return fleet, err
+			}
+		}
+	}
+
+	if e.Applications != nil {
+		rsp, err := e.Applications.GetApplications(ctx)
+		if err != nil {
+			return fleet, err
+		}
+		for _, app := range rsp.GetItems() {
+			if !Running(app.GetStatus()) {
+				continue
+			}
+			est := Estimate{
+				Kind:          Application,
+				Name:          app.GetId(),
+				Cluster:       app.GetCluster(),
+				Rpool:         app.GetResourcePool(),
+				Configuration: app.GetHardwarePackageName(),
+				User:          app.GetCreatedBy(),
+				Status:        app.GetStatus(),
+			}
+			est.Hourly, err = e.applicationPrice(ctx, est.Cluster, est.Rpool, est.Configuration)
+			if err := fleet.price(est, err); err != nil {
+				return fleet, err
+			}
+		}
+	}
+
+	return fleet, nil
+}
+
+// BilledStatuses are the statuses in which a resource holds its hardware and is billed.
+// Resources which failed, are still pending or have no status aren't billed.
+var BilledStatuses = []string{"ONLINE", "STARTING", "STOPPING"}
+
+// Running reports whether a resource with the given status is being billed (see BilledStatuses).
+func Running(status string) bool {
+	return slices.Contains(BilledStatuses, status)
+}
+
+// serverPrice prefers the rpool specific availability price, falling back to the configuration list price.
+// Without an rpool, the availability of every rpool is returned, so the cheapest one is used.
+func (e *Estimator) serverPrice(ctx context.Context, cluster, rpool, configuration string) (float64, error) {
+	if e.Servers == nil {
+		return 0, fmt.Errorf("no virtual client provided to price configuration %s", configuration)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.serverAvailability == nil {
+		e.serverAvailability = map[string]map[string]float64{}
+	}
+	key := cluster + "/" + rpool
+	if _, ok := e.serverAvailability[key]; !ok {
+		params := &virtual.GetAvailabilityParams{Cluster: cluster}
+		if rpool != "" {
+			params.ResourcePool = &rpool
+		}
+		rsp, err := e.Servers.GetAvailability(ctx, params)
+		if err != nil {
+			return 0, err
+		}
+		prices := map[string]float64{}
+		for _, avail := range rsp.GetItems() {
+			if avail.Price == nil || (rpool != "" && avail.GetRpool() != "" && avail.GetRpool() != rpool) {
+				continue
+			}
+			name := avail.GetConfiguration()
+			if price, ok := prices[name]; !ok || *avail.Price < price {
+				prices[name] = *avail.Price
+			}
+		}
+		e.serverAvailability[key] = prices
+	}
+	if price, ok := e.serverAvailability[key][configuration]; ok {
+		return price, nil
+	}
+
+	if e.serverPrices == nil {
+		rsp, err := e.Servers.GetConfigurations(ctx)
+		if err != nil {
+			return 0, err
+		}
+		e.serverPrices = map[string]float64{}
+		for _, config := range rsp.GetItems() {
+			if config.Price != nil {
+				e.serverPrices[config.GetName()] = *config.Price
+			}
+		}
+	}
+	if price, ok := e.serverPrices[configuration]; ok {
+		return price, nil
+	}
+	return 0, fmt.Errorf("%w for server configuration %s", ErrNoPrice, configuration)
+}
+
+// applicationPrice prefers the rpool specific availability price, falling back to the hardware package list price.
+func (e *Estimator) applicationPrice(ctx context.Context, cluster, rpool, configuration string) (float64, error) {
+	if e.Applications == nil {
+		return 0, fmt.Errorf("no applications client provided to price hardware package %s", configuration)
+	}
+	if rpool == "" {
+		rpool = "on-demand"
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.appAvailability == nil {
+		e.appAvailability = map[string]map[string]float64{}
+	}
+	key := cluster + "/" + rpool
+	if _, ok := e.appAvailability[key]; !ok {
+		rsp, err := e.Applications.GetAvailability(
+			ctx,
+			&applications.GetAvailabilityParams{Cluster: cluster, ResourcePool: &rpool},
+		)
+		if err != nil {
+			return 0, err
+		}
+		prices := map[string]float64{}
+		for _, avail := range rsp.GetItems() {
+			if avail.Price != nil {
+				prices[avail.GetConfiguration()] = *avail.Price
+			}
+		}
+		e.appAvailability[key] = prices
+	}
+	if price, ok := e.appAvailability[key][configuration]; ok {
+		return price, nil
+	}
+
+	if e.applicationPrices == nil {
+		rsp, err := e.Applications.GetConfigurations(ctx)
+		if err != nil {
+			return 0, err
+		}
+		e.applicationPrices = map[string]float64{}
+		for _, config := range rsp.GetItems() {
+			if config.PricePerHour != nil {
+				e.applicationPrices[config.GetName()] = *config.PricePerHour
+			}
+		}
+	}
+	if price, ok := e.applicationPrices[configuration]; ok {
+		return price, nil
+	}
+	return 0, fmt.Errorf("%w for application hardware package %s", ErrNoPrice, configuration)
+}
@@ -0,0 +1,141 @@
+package cost_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/cost"
+	"github.com/denvrdata/go-denvr/denvrtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func TestRunning(t *testing.T) {
+	for _, status := range []string{"ONLINE", "STARTING", "STOPPING"} {
+		assert.True(t, cost.Running(status), status)
+	}
+	for _, status := range []string{"OFFLINE", "FAILED", "ERROR", "PENDING", ""} {
+		assert.False(t, cost.Running(status), status)
+	}
+}
+
+func TestEstimator(t *testing.T) {
+	server := denvrtest.NewServer(t)
+	server.Reply("/api/v1/servers/virtual/GetConfigurations", http.StatusOK, `{"items": [{"name": "A100_40GB_PCIe_1x", "price": 2.05}, {"name": "H100_80GB_SXM_8x", "price": 20.0}]}`)
+	server.HandleFunc(
+		"/api/v1/servers/virtual/GetAvailability",
+		func(resp http.ResponseWriter, req *http.Request) {
+			switch req.URL.Query().Get("resourcePool") {
+			case "reserved-denvr":
+				resp.Write([]byte(`{"items": [{"configuration": "H100_80GB_SXM_8x", "rpool": "reserved-denvr", "price": 0.0}]}`))
+			case "":
+				// Every rpool, in no particular order
+				resp.Write([]byte(`{
+					"items": [
+						{"configuration": "H100_80GB_SXM_8x", "rpool": "on-demand", "price": 20.0},
+						{"configuration": "H100_80GB_SXM_8x", "rpool": "reserved-denvr", "price": 0.0},
+						{"configuration": "H100_80GB_SXM_8x", "rpool": "spot", "price": 8.0}
+					]
+				}`))
+			default:
+				resp.Write([]byte(`{"items": []}`))
+			}
+		},
+	)
+	server.Reply(
+		"/api/v1/servers/virtual/GetServers",
+		http.StatusOK,
+		`{
+			"items": [
+				{"id": "a", "cluster": "Msc1", "rpool": "on-demand", "configuration": "A100_40GB_PCIe_1x", "username": "alice", "status": "ONLINE"},
+				{"id": "b", "cluster": "Hou1", "rpool": "on-demand", "configuration": "A100_40GB_PCIe_1x", "username": "bob", "status": "ONLINE"},
+				{"id": "c", "cluster": "Hou1", "rpool": "on-demand", "configuration": "H100_80GB_SXM_8x", "username": "bob", "status": "OFFLINE"},
+				{"id": "d", "cluster": "Hou1", "rpool": "reserved-denvr", "configuration": "H100_80GB_SXM_8x", "username": "bob", "status": "ONLINE"},
+				{"id": "e", "cluster": "Hou1", "rpool": "on-demand", "configuration": "H100_80GB_SXM_8x", "username": "bob", "status": "FAILED"},
+				{"id": "f", "cluster": "Hou1", "rpool": "on-demand", "configuration": "H100_80GB_SXM_8x", "username": "bob", "status": "PENDING"},
+				{"id": "g", "cluster": "Hou1", "rpool": "on-demand", "configuration": "H100_80GB_SXM_8x", "username": "bob"},
+				{"id": "h", "cluster": "Msc1", "rpool": "on-demand", "configuration": "retired", "username": "carol", "status": "ONLINE"}
+			]
+		}`,
+	)
+	server.Reply("/api/v1/servers/applications/GetConfigurations", http.StatusOK, `{"items": [{"name": "cpu-4vcpu", "pricePerHour": 0.25}]}`)
+	server.Reply("/api/v1/servers/applications/GetAvailability", http.StatusOK, `{"items": [{"configuration": "cpu-4vcpu"}]}`)
+	server.Reply("/api/v1/servers/applications/GetApplications", http.StatusOK, `{"items": [{"id": "notebook", "cluster": "Msc1", "resourcePool": "on-demand", "hardwarePackageName": "cpu-4vcpu", "createdBy": "alice", "status": "ONLINE"}]}`)
+	e := &cost.Estimator{
+		Servers:      server.Virtual(),
+		Applications: server.Applications(),
+	}
+
+	t.Run(
+		"Server",
+		func(t *testing.T) {
+			est, err := e.Server(
+				context.TODO(),
+				virtual.CreateVirtualServerInput{Cluster: "Msc1", Configuration: "A100_40GB_PCIe_1x", Rpool: ptr("on-demand")},
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, 2.05, est.Hourly)
+			assert.InDelta(t, 49.2, est.Projected(24*time.Hour), 1e-9)
+
+			// Reserved pools use the availability price
+			est, err = e.Server(
+				context.TODO(),
+				virtual.CreateVirtualServerInput{Cluster: "Hou1", Configuration: "H100_80GB_SXM_8x", Rpool: ptr("reserved-denvr")},
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, 0.0, est.Hourly)
+
+			// Without an rpool, the cheapest one is used whatever the order of the availability
+			est, err = e.Server(context.TODO(), virtual.CreateVirtualServerInput{Cluster: "Hou1", Configuration: "H100_80GB_SXM_8x"})
+			assert.NoError(t, err)
+			assert.Equal(t, 0.0, est.Hourly)
+
+			_, err = e.Server(context.TODO(), virtual.CreateVirtualServerInput{Cluster: "Msc1", Configuration: "missing"})
+			assert.ErrorIs(t, err, cost.ErrNoPrice)
+			assert.ErrorContains(t, err, "no price found for server configuration missing")
+		},
+	)
+
+	t.Run(
+		"CatalogApplication",
+		func(t *testing.T) {
+			est, err := e.CatalogApplication(
+				context.TODO(),
+				applications.ApplicationsApiCreateRequest{Cluster: "Msc1", HardwarePackageName: "cpu-4vcpu"},
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, 0.25, est.Hourly)
+			assert.Equal(t, cost.Application, est.Kind)
+			assert.Equal(t, "cpu-4vcpu", est.Configuration)
+		},
+	)
+
+	t.Run(
+		"Fleet",
+		func(t *testing.T) {
+			fleet, err := e.Fleet(context.TODO())
+			assert.NoError(t, err)
+			assert.Len(t, fleet.Items, 4)
+			assert.InDelta(t, 4.35, fleet.Hourly, 1e-9)
+			assert.InDelta(t, 2.3, fleet.ByCluster["Msc1"], 1e-9)
+			assert.InDelta(t, 2.05, fleet.ByCluster["Hou1"], 1e-9)
+			assert.InDelta(t, 4.35, fleet.ByRpool["on-demand"], 1e-9)
+			assert.InDelta(t, 0.0, fleet.ByRpool["reserved-denvr"], 1e-9)
+			assert.InDelta(t, 2.3, fleet.ByUser["alice"], 1e-9)
+			assert.InDelta(t, 2.05, fleet.ByUser["bob"], 1e-9)
+			assert.InDelta(t, 4.35*730, fleet.Projected(730*time.Hour), 1e-9)
+
+			// Unpriced resources don't fail the fleet
+			require.Len(t, fleet.Unpriced, 1)
+			assert.Equal(t, "h", fleet.Unpriced[0].Name)
+			assert.Equal(t, "carol", fleet.Unpriced[0].User)
+			assert.ErrorIs(t, fleet.Unpriced[0].Err, cost.ErrNoPrice)
+		},
+	)
+}
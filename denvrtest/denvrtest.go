@@ -0,0 +1,77 @@
+// Package denvrtest provides a fake Denvr API server for testing code built on the generated clients.
+//
+// Usage:
+//
+//	server := denvrtest.NewServer(t)
+//	server.Reply("/api/v1/servers/virtual/GetServers", http.StatusOK, `{"items": [{"id": "vm", "cluster": "Msc1"}]}`)
+//	vc := server.Virtual()
+//
+// The server is closed when the test finishes.
+package denvrtest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+)
+
+// Server is an httptest.Server which routes API paths to per-test handlers.
+type Server struct {
+	*httptest.Server
+	mux *http.ServeMux
+}
+
+// NewServer starts an empty Server, which responds with a 404 Not Found API error until routes are added.
+func NewServer(t testing.TB) *Server {
+	return Serve(
+		t,
+		http.HandlerFunc(
+			func(resp http.ResponseWriter, req *http.Request) {
+				resp.Header().Set("Content-Type", "application/json")
+				resp.WriteHeader(http.StatusNotFound)
+				fmt.Fprintf(resp, `{"success": false, "error": {"code": 404, "message": "no handler for %s"}}`, req.URL.Path)
+			},
+		),
+	)
+}
+
+// Serve starts a Server for a fake which handles its own routing (e.g., one tracking resource state).
+// Routes added with HandleFunc or Reply take priority over the handler.
+func Serve(t testing.TB, handler http.Handler) *Server {
+	mux := http.NewServeMux()
+	mux.Handle("/", handler)
+	s := &Server{Server: httptest.NewServer(mux), mux: mux}
+	t.Cleanup(s.Close)
+	return s
+}
+
+// HandleFunc routes requests for the path (e.g., /api/v1/servers/virtual/GetServers) to the handler.
+func (s *Server) HandleFunc(path string, handler http.HandlerFunc) {
+	s.mux.HandleFunc(path, handler)
+}
+
+// Reply responds to every request for the path with the status and JSON body.
+func (s *Server) Reply(path string, status int, body string) {
+	s.HandleFunc(
+		path,
+		func(resp http.ResponseWriter, req *http.Request) {
+			resp.Header().Set("Content-Type", "application/json")
+			resp.WriteHeader(status)
+			resp.Write([]byte(body))
+		},
+	)
+}
+
+// Virtual returns a virtual servers client for the Server.
+func (s *Server) Virtual() *virtual.Client {
+	return &virtual.Client{Server: s.URL, Client: s.Client()}
+}
+
+// Applications returns an applications client for the Server.
+func (s *Server) Applications() *applications.Client {
+	return &applications.Client{Server: s.URL, Client: s.Client()}
+}
@@ -0,0 +1,53 @@
+package denvrtest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/denvrtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServer(t *testing.T) {
+	server := denvrtest.NewServer(t)
+	server.Reply("/api/v1/servers/virtual/GetServer", http.StatusOK, `{"id": "vm", "cluster": "Msc1"}`)
+	server.Reply("/api/v1/servers/applications/GetApplications", http.StatusServiceUnavailable, `{"error": {"message": "cluster unavailable"}}`)
+
+	vm, err := server.Virtual().GetServer(context.TODO(), &virtual.GetServerParams{Id: "vm"})
+	assert.NoError(t, err)
+	assert.Equal(t, "vm", vm.GetId())
+
+	_, err = server.Applications().GetApplications(context.TODO())
+	assert.EqualError(t, err, "503 Service Unavailable - cluster unavailable")
+
+	// Routes without a handler aren't found
+	_, err = server.Virtual().GetServers(context.TODO(), &virtual.GetServersParams{})
+	assert.EqualError(t, err, "404 Not Found - no handler for /api/v1/servers/virtual/GetServers")
+}
+
+func TestServe(t *testing.T) {
+	calls := 0
+	server := denvrtest.Serve(
+		t,
+		http.HandlerFunc(
+			func(resp http.ResponseWriter, req *http.Request) {
+				calls++
+				resp.Write([]byte(`{"id": "vm", "cluster": "Msc1"}`))
+			},
+		),
+	)
+	server.Reply("/api/v1/servers/applications/GetApplications", http.StatusOK, `{"items": []}`)
+
+	vm, err := server.Virtual().GetServer(context.TODO(), &virtual.GetServerParams{Id: "vm"})
+	assert.NoError(t, err)
+	assert.Equal(t, "vm", vm.GetId())
+	assert.Equal(t, 1, calls)
+
+	// Added routes take priority over the fake
+	apps, err := server.Applications().GetApplications(context.TODO())
+	assert.NoError(t, err)
+	assert.Empty(t, apps.GetItems())
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,350 @@
+// Package recorder records API interactions to cassettes on disk and replays them offline.
+//
+// The mode is selected with the DENVR_RECORDER_MODE environment variable (record, replay or passthrough)
+// and defaults to replay, so CI never talks to the API.
+//
+// Usage:
+//
+//	rec, err := recorder.New("testdata/get-servers.json", http.DefaultClient)
+//	defer rec.Stop()
+//	client := virtual.Client{Server: conf.Server, Client: rec, RequestEditors: []virtual.RequestEditorFn{conf.Auth.Intercept}}
+//
+// Record against dev once with DENVR_RECORDER_MODE=record go test ./..., then commit the cassettes.
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// EnvMode is the environment variable which selects the Mode.
+const EnvMode = "DENVR_RECORDER_MODE"
+
+// Redacted replaces scrubbed values.
+const Redacted = "REDACTED"
+
+// Mode determines whether requests reach the API.
+type Mode string
+
+const (
+	// Record sends requests to the API and saves the interactions on Stop
+	Record Mode = "record"
+	// Replay answers requests from the cassette without any network access
+	Replay Mode = "replay"
+	// Passthrough sends requests to the API without recording anything
+	Passthrough Mode = "passthrough"
+)
+
+// ModeFromEnv returns the Mode selected by EnvMode, defaulting to Replay.
+func ModeFromEnv() (Mode, error) {
+	switch mode := Mode(strings.ToLower(os.Getenv(EnvMode))); mode {
+	case "":
+		return Replay, nil
+	case Record, Replay, Passthrough:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid %s %q, expected record, replay or passthrough", EnvMode, mode)
+	}
+}
+
+// ErrNoMatch is returned in Replay mode for requests which aren't in the cassette.
+var ErrNoMatch = errors.New("recorder: no matching interaction")
+
+// HttpRequestDoer performs HTTP requests, like the generated clients' HttpRequestDoer.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Request is the scrubbed request of an interaction.
+type Request struct {
+	Method  string      `json:"method"`
+	Path    string      `json:"path"`
+	Query   string      `json:"query,omitempty"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// Response is the scrubbed response of an interaction.
+type Response struct {
+	Status  int         `json:"status"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Cassette is the file of recorded interactions.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Recorder is an HttpRequestDoer which records or replays interactions.
+type Recorder struct {
+	Path string
+	Mode Mode
+	// Client performs the real requests in Record and Passthrough mode
+	Client HttpRequestDoer
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// New creates a recorder in the mode selected by EnvMode. In Replay mode the cassette at path must exist.
+func New(path string, client HttpRequestDoer) (*Recorder, error) {
+	mode, err := ModeFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return NewWithMode(path, mode, client)
+}
+
+// NewWithMode creates a recorder in an explicit mode.
+func NewWithMode(path string, mode Mode, client HttpRequestDoer) (*Recorder, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	r := &Recorder{Path: path, Mode: mode, Client: client}
+	if mode == Replay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("loading cassette (record it with %s=record): %w", EnvMode, err)
+		}
+		if err := json.Unmarshal(data, &r.cassette); err != nil {
+			return nil, fmt.Errorf("loading cassette %s: %w", path, err)
+		}
+		r.used = make([]bool, len(r.cassette.Interactions))
+	}
+	return r, nil
+}
+
+// Do records, replays or passes through the request depending on the mode.
+func (r *Recorder) Do(req *http.Request) (*http.Response, error) {
+	switch r.Mode {
+	case Passthrough:
+		return r.Client.Do(req)
+	case Record:
+		return r.record(req)
+	default:
+		return r.replay(req)
+	}
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := r.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	rspBody, err := readBody(&rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: Request{
+			Method:  req.Method,
+			Path:    req.URL.Path,
+			Query:   scrubQuery(req.URL.Query()),
+			Headers: scrubHeaders(req.Header),
+			Body:    scrubBody(body),
+		},
+		Response: Response{
+			Status:  rsp.StatusCode,
+			Headers: scrubHeaders(rsp.Header),
+			Body:    scrubBody(rspBody),
+		},
+	})
+	return rsp, nil
+}
+
+// replay answers with the first unused matching interaction, so repeated requests (e.g., polling)
+// replay in the order they were recorded. Once those are used up, the last match is repeated.
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	want := Request{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  scrubQuery(req.URL.Query()),
+		Body:   scrubBody(body),
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	found := -1
+	for i, interaction := range r.cassette.Interactions {
+		if !want.matches(interaction.Request) {
+			continue
+		}
+		found = i
+		if !r.used[i] {
+			break
+		}
+	}
+	if found < 0 {
+		return nil, fmt.Errorf("%w in %s for %s %s", ErrNoMatch, r.Path, req.Method, req.URL.RequestURI())
+	}
+	r.used[found] = true
+
+	recorded := r.cassette.Interactions[found].Response
+	header := recorded.Headers.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	// Scrubbing may have changed the length of the body
+	header.Del("Content-Length")
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.Status, http.StatusText(recorded.Status)),
+		StatusCode:    recorded.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
+
+func (req Request) matches(recorded Request) bool {
+	return req.Method == recorded.Method &&
+		req.Path == recorded.Path &&
+		req.Query == recorded.Query &&
+		req.Body == recorded.Body
+}
+
+// Stop saves the cassette in Record mode.
+func (r *Recorder) Stop() error {
+	if r.Mode != Record {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.Path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.Path, append(data, '\n'), 0o644)
+}
+
+// Interactions returns a copy of the recorded or loaded interactions.
+func (r *Recorder) Interactions() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.cassette.Interactions)
+}
+
+// readBody reads and restores a request or response body.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	data, err := io.ReadAll(*body)
+	(*body).Close()
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, err
+}
+
+var (
+	sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie", "X-Api-Key"}
+	sensitiveKeys    = []string{"password", "token", "apikey", "api_key", "secret", "authorization"}
+)
+
+// sensitive reports whether a query parameter or JSON field name looks like a credential
+// (e.g., password, accessToken, refreshToken).
+func sensitive(key string) bool {
+	key = strings.ToLower(key)
+	return slices.ContainsFunc(sensitiveKeys, func(s string) bool { return strings.Contains(key, s) })
+}
+
+func scrubHeaders(h http.Header) http.Header {
+	if len(h) == 0 {
+		return nil
+	}
+	h = h.Clone()
+	for _, name := range sensitiveHeaders {
+		if h.Get(name) != "" {
+			h.Set(name, Redacted)
+		}
+	}
+	return h
+}
+
+func scrubQuery(q url.Values) string {
+	for key, values := range q {
+		if sensitive(key) {
+			for i := range values {
+				values[i] = Redacted
+			}
+		}
+	}
+	return q.Encode()
+}
+
+// scrubBody redacts the strings in sensitive JSON fields, including arrays or objects of them (e.g., proxyApiKeys).
+// Other values are kept as is, so numeric fields like refreshTokenExpireInSeconds still decode on replay.
+// JSON is also re-encoded compactly, so matching isn't sensitive to formatting or key order, while numbers
+// and HTML characters are written as they were received. Other bodies are kept as is.
+func scrubBody(body []byte) string {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v any
+	if len(body) == 0 || dec.Decode(&v) != nil {
+		return string(body)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return string(body)
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(scrubValue(v, false)); err != nil {
+		return string(body)
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// scrubValue redacts the strings in v if redact is set or they're under a sensitive key.
+func scrubValue(v any, redact bool) any {
+	switch v := v.(type) {
+	case string:
+		if redact {
+			return Redacted
+		}
+	case map[string]any:
+		for key, value := range v {
+			v[key] = scrubValue(value, redact || sensitive(key))
+		}
+	case []any:
+		for i := range v {
+			v[i] = scrubValue(v[i], redact)
+		}
+	}
+	return v
+}
@@ -0,0 +1,238 @@
+package recorder_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/auth"
+	"github.com/denvrdata/go-denvr/denvrtest/recorder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModeFromEnv(t *testing.T) {
+	t.Setenv(recorder.EnvMode, "")
+	mode, err := recorder.ModeFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, recorder.Replay, mode)
+
+	t.Setenv(recorder.EnvMode, "RECORD")
+	mode, err = recorder.ModeFromEnv()
+	require.NoError(t, err)
+	assert.Equal(t, recorder.Record, mode)
+
+	t.Setenv(recorder.EnvMode, "rewind")
+	_, err = recorder.ModeFromEnv()
+	assert.ErrorContains(t, err, `invalid DENVR_RECORDER_MODE "rewind"`)
+}
+
+func TestRecordReplay(t *testing.T) {
+	polls := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc(
+		"/api/TokenAuth/Authenticate",
+		func(resp http.ResponseWriter, req *http.Request) {
+			resp.Write([]byte(`{"result": {"accessToken": "access1", "refreshToken": "refresh", "expireInSeconds": 600}}`))
+		},
+	)
+	mux.HandleFunc(
+		"/api/v1/servers/virtual/GetServer",
+		func(resp http.ResponseWriter, req *http.Request) {
+			polls++
+			status := "PENDING"
+			if polls > 1 {
+				status = "ONLINE"
+			}
+			fmt.Fprintf(resp, `{"id": %q, "status": %q}`, req.URL.Query().Get("Id"), status)
+		},
+	)
+	server := httptest.NewServer(mux)
+	path := filepath.Join(t.TempDir(), "cassettes", "get-server.json")
+
+	rec, err := recorder.NewWithMode(path, recorder.Record, server.Client())
+	require.NoError(t, err)
+
+	login, err := http.NewRequest(
+		http.MethodPost,
+		server.URL+"/api/TokenAuth/Authenticate",
+		strings.NewReader(`{"userNameOrEmailAddress": "alice@denvrdata.com", "password": "hunter2"}`),
+	)
+	require.NoError(t, err)
+	rsp, err := rec.Do(login)
+	require.NoError(t, err)
+	body, err := io.ReadAll(rsp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "access1", "the caller still sees the real response")
+
+	client := virtual.Client{
+		Server:         server.URL,
+		Client:         rec,
+		RequestEditors: []virtual.RequestEditorFn{auth.NewApiKey("secret-key").Intercept},
+	}
+	params := &virtual.GetServerParams{Id: "vm1", Namespace: "denvr", Cluster: "Msc1"}
+	for _, want := range []string{"PENDING", "ONLINE"} {
+		vm, err := client.GetServer(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, want, *vm.Status)
+	}
+	require.NoError(t, rec.Stop())
+	server.Close()
+
+	// Credentials never reach the cassette
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	for _, secret := range []string{"hunter2", "access1", "refresh\"", "secret-key"} {
+		assert.NotContains(t, string(data), secret)
+	}
+	var cassette recorder.Cassette
+	require.NoError(t, json.Unmarshal(data, &cassette))
+	require.Len(t, cassette.Interactions, 3)
+	assert.Equal(t, recorder.Redacted, cassette.Interactions[1].Request.Headers.Get("Authorization"))
+	assert.Equal(t, "Cluster=Msc1&Id=vm1&Namespace=denvr", cassette.Interactions[1].Request.Query)
+
+	// Replaying works without the server and in the recorded order
+	rec, err = recorder.NewWithMode(path, recorder.Replay, nil)
+	require.NoError(t, err)
+	client.Client = rec
+
+	login, err = http.NewRequest(
+		http.MethodPost,
+		server.URL+"/api/TokenAuth/Authenticate",
+		strings.NewReader(`{"password": "different", "userNameOrEmailAddress": "alice@denvrdata.com"}`),
+	)
+	require.NoError(t, err)
+	rsp, err = rec.Do(login)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode)
+
+	for _, want := range []string{"PENDING", "ONLINE", "ONLINE"} {
+		vm, err := client.GetServer(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, want, *vm.Status)
+	}
+
+	// Requests are matched on the method, path, query and body
+	_, err = client.GetServer(context.Background(), &virtual.GetServerParams{Id: "vm2", Namespace: "denvr", Cluster: "Msc1"})
+	assert.ErrorIs(t, err, recorder.ErrNoMatch)
+	assert.ErrorContains(t, err, "GET /api/v1/servers/virtual/GetServer?Cluster=Msc1&Id=vm2&Namespace=denvr")
+
+	login, err = http.NewRequest(
+		http.MethodPost,
+		server.URL+"/api/TokenAuth/Authenticate",
+		strings.NewReader(`{"userNameOrEmailAddress": "bob@denvrdata.com", "password": "hunter2"}`),
+	)
+	require.NoError(t, err)
+	_, err = rec.Do(login)
+	assert.ErrorIs(t, err, recorder.ErrNoMatch)
+	assert.NoError(t, rec.Stop())
+}
+
+func TestScrub(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		resp.Write([]byte(`{"result": {"id": "app1", "proxyApiKeys": ["key1", "key2"], "price": 1.50, "size": 12345678901234567890, "command": "a < b && c > d"}}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	rec, err := recorder.NewWithMode(path, recorder.Record, server.Client())
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(
+		http.MethodPost,
+		server.URL+"/api/v1/servers/applications/CreateCustomApplication",
+		strings.NewReader(`{"name": "app1", "proxyApiKeys": ["hunter2"], "registry": {"credentials": {"username": "alice", "password": "hunter3"}}, "secrets": {"db": "hunter4"}}`),
+	)
+	require.NoError(t, err)
+	_, err = rec.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, rec.Stop())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	for _, secret := range []string{"hunter2", "hunter3", "hunter4", "key1", "key2"} {
+		assert.NotContains(t, string(data), secret)
+	}
+	interaction := rec.Interactions()[0]
+	assert.JSONEq(
+		t,
+		`{"name": "app1", "proxyApiKeys": ["REDACTED"], "registry": {"credentials": {"username": "alice", "password": "REDACTED"}}, "secrets": {"db": "REDACTED"}}`,
+		interaction.Request.Body,
+	)
+	// Numbers and HTML characters are kept exactly
+	assert.Equal(
+		t,
+		`{"result":{"command":"a < b && c > d","id":"app1","price":1.50,"proxyApiKeys":["REDACTED","REDACTED"],"size":12345678901234567890}}`,
+		interaction.Response.Body,
+	)
+}
+
+// transport lets an *http.Client (e.g., for auth.NewBearer) send requests through a Recorder.
+type transport func(*http.Request) (*http.Response, error)
+
+func (t transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t(req)
+}
+
+func TestTokenReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		resp.Write([]byte(`{"result": {"accessToken": "access1", "refreshToken": "refresh1", "expireInSeconds": 600, "refreshTokenExpireInSeconds": 86400}}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	rec, err := recorder.NewWithMode(path, recorder.Record, server.Client())
+	require.NoError(t, err)
+	auth.NewBearer(server.URL, "alice@denvrdata.com", "hunter2", &http.Client{Transport: transport(rec.Do)})
+	require.NoError(t, rec.Stop())
+
+	// Only the tokens are redacted, so the numeric *Token* fields still decode
+	assert.JSONEq(
+		t,
+		`{"result": {"accessToken": "REDACTED", "refreshToken": "REDACTED", "expireInSeconds": 600, "refreshTokenExpireInSeconds": 86400}}`,
+		rec.Interactions()[0].Response.Body,
+	)
+
+	rec, err = recorder.NewWithMode(path, recorder.Replay, nil)
+	require.NoError(t, err)
+	bearer := auth.NewBearer(server.URL, "alice@denvrdata.com", "hunter2", &http.Client{Transport: transport(rec.Do)})
+	assert.Equal(t, recorder.Redacted, bearer.AccessToken)
+	assert.InDelta(t, time.Now().Unix()+86400, bearer.RefreshExpires, 5)
+	assert.NoError(t, rec.Stop())
+}
+
+func TestPassthrough(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		resp.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	t.Setenv(recorder.EnvMode, "passthrough")
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	rec, err := recorder.New(path, server.Client())
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	rsp, err := rec.Do(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rsp.StatusCode)
+	require.NoError(t, rec.Stop())
+	assert.NoFileExists(t, path)
+	assert.Empty(t, rec.Interactions())
+}
+
+func TestMissingCassette(t *testing.T) {
+	t.Setenv(recorder.EnvMode, "")
+	_, err := recorder.New(filepath.Join(t.TempDir(), "missing.json"), nil)
+	assert.ErrorContains(t, err, "record it with DENVR_RECORDER_MODE=record")
+}
@@ -0,0 +1,224 @@
+// Package fanout queries multiple clusters concurrently and merges the results.
+package fanout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+)
+
+// Tagged pairs an item with the cluster it came from.
+type Tagged[T any] struct {
+	Cluster string
+	Item    T
+}
+
+// Result holds the merged items from every cluster which succeeded, along with the errors from those which didn't.
+type Result[T any] struct {
+	Items  []Tagged[T]
+	Errors map[string]error
+}
+
+// Err joins the per-cluster errors, returning nil if every cluster succeeded.
+func (r Result[T]) Err() error {
+	var errs []error
+	for _, cluster := range slices.Sorted(maps.Keys(r.Errors)) {
+		errs = append(errs, fmt.Errorf("%s: %w", cluster, r.Errors[cluster]))
+	}
+	return errors.Join(errs...)
+}
+
+// Options controls which clusters are queried and how many are queried at once.
+type Options struct {
+	// Clusters to query. If empty, the clusters are discovered from the available configurations.
+	Clusters []string
+	// Workers is the maximum number of concurrent requests (defaults to 4)
+	Workers int
+}
+
+// Map calls fn for every cluster using a bounded pool of workers.
+// Items are returned in cluster order, regardless of which requests finish first.
+func Map[T any](ctx context.Context, clusters []string, workers int, fn func(ctx context.Context, cluster string) ([]T, error)) Result[T] {
+	if workers <= 0 {
+		workers = 4
+	}
+
+	results := make([][]T, len(clusters))
+	errs := make([]error, len(clusters))
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for range min(workers, len(clusters)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				if err := ctx.Err(); err != nil {
+					errs[i] = err
+					continue
+				}
+				results[i], errs[i] = fn(ctx, clusters[i])
+			}
+		}()
+	}
+	for i := range clusters {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	result := Result[T]{Errors: map[string]error{}}
+	for i, cluster := range clusters {
+		if errs[i] != nil {
+			result.Errors[cluster] = errs[i]
+			continue
+		}
+		for _, item := range results[i] {
+			result.Items = append(result.Items, Tagged[T]{cluster, item})
+		}
+	}
+	return result
+}
+
+// Clusters discovers the set of clusters from the server and application configurations.
+// Either client may be nil.
+func Clusters(ctx context.Context, vc virtual.ClientInterface, ac applications.ClientInterface) ([]string, error) {
+	found := map[string]bool{}
+	if vc != nil {
+		rsp, err := vc.GetConfigurations(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, config := range rsp.GetItems() {
+			for _, cluster := range config.GetClusters() {
+				found[cluster] = true
+			}
+		}
+	}
+	if ac != nil {
+		rsp, err := ac.GetConfigurations(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, config := range rsp.GetItems() {
+			for _, cluster := range config.GetClusters() {
+				found[cluster] = true
+			}
+		}
+	}
+	return slices.Sorted(maps.Keys(found)), nil
+}
+
+// Servers lists the virtual servers in each cluster.
+// The error is only returned if cluster discovery fails, per-cluster failures are reported in the Result.
+func Servers(ctx context.Context, vc virtual.ClientInterface, opts Options) (Result[virtual.VirtualServerDetailsItem], error) {
+	clusters, err := resolve(ctx, opts, vc, nil)
+	if err != nil {
+		return Result[virtual.VirtualServerDetailsItem]{}, err
+	}
+	return Map(
+		ctx,
+		clusters,
+		opts.Workers,
+		func(ctx context.Context, cluster string) ([]virtual.VirtualServerDetailsItem, error) {
+			rsp, err := vc.GetServers(ctx, &virtual.GetServersParams{Cluster: &cluster})
+			if err != nil {
+				return nil, err
+			}
+			return rsp.GetItems(), nil
+		},
+	), nil
+}
+
+// ServerAvailability checks the availability of every server configuration in each cluster.
+func ServerAvailability(
+	ctx context.Context,
+	vc virtual.ClientInterface,
+	rpool *string,
+	opts Options,
+) (Result[virtual.ServerAvailability], error) {
+	clusters, err := resolve(ctx, opts, vc, nil)
+	if err != nil {
+		return Result[virtual.ServerAvailability]{}, err
+	}
+	return Map(
+		ctx,
+		clusters,
+		opts.Workers,
+		func(ctx context.Context, cluster string) ([]virtual.ServerAvailability, error) {
+			rsp, err := vc.GetAvailability(ctx, &virtual.GetAvailabilityParams{Cluster: cluster, ResourcePool: rpool})
+			if err != nil {
+				return nil, err
+			}
+			return rsp.GetItems(), nil
+		},
+	), nil
+}
+
+// Applications lists applications grouped by cluster.
+// NOTE: GetApplications doesn't support filtering by cluster, so we make a single request
+// and tag each application with its own cluster.
+// A failed request is reported as an error for every requested cluster.
+func Applications(ctx context.Context, ac applications.ClientInterface, opts Options) (Result[applications.ApplicationsApiOverview], error) {
+	clusters, err := resolve(ctx, opts, nil, ac)
+	if err != nil {
+		return Result[applications.ApplicationsApiOverview]{}, err
+	}
+
+	result := Result[applications.ApplicationsApiOverview]{Errors: map[string]error{}}
+	rsp, err := ac.GetApplications(ctx)
+	if err != nil {
+		for _, cluster := range clusters {
+			result.Errors[cluster] = err
+		}
+		return result, nil
+	}
+
+	apps := rsp.GetItems()
+	for _, cluster := range clusters {
+		for _, app := range apps {
+			if app.GetCluster() == cluster {
+				result.Items = append(result.Items, Tagged[applications.ApplicationsApiOverview]{cluster, app})
+			}
+		}
+	}
+	return result, nil
+}
+
+// ApplicationAvailability checks the availability of every application hardware package in each cluster.
+// Unlike servers, the applications API requires a resource pool, so a nil rpool fails for each cluster.
+func ApplicationAvailability(
+	ctx context.Context,
+	ac applications.ClientInterface,
+	rpool *string,
+	opts Options,
+) (Result[applications.ApplicationsApiApplicationConfigAvailability], error) {
+	clusters, err := resolve(ctx, opts, nil, ac)
+	if err != nil {
+		return Result[applications.ApplicationsApiApplicationConfigAvailability]{}, err
+	}
+	return Map(
+		ctx,
+		clusters,
+		opts.Workers,
+		func(ctx context.Context, cluster string) ([]applications.ApplicationsApiApplicationConfigAvailability, error) {
+			rsp, err := ac.GetAvailability(ctx, &applications.GetAvailabilityParams{Cluster: cluster, ResourcePool: rpool})
+			if err != nil {
+				return nil, err
+			}
+			return rsp.GetItems(), nil
+		},
+	), nil
+}
+
+func resolve(ctx context.Context, opts Options, vc virtual.ClientInterface, ac applications.ClientInterface) ([]string, error) {
+	if len(opts.Clusters) > 0 {
+		return opts.Clusters, nil
+	}
+	return Clusters(ctx, vc, ac)
+}
@@ -0,0 +1,99 @@
+package fanout_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/denvrdata/go-denvr/denvrtest"
+	"github.com/denvrdata/go-denvr/fanout"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFanout(t *testing.T) {
+	server := denvrtest.NewServer(t)
+	server.Reply("/api/v1/servers/virtual/GetConfigurations", http.StatusOK, `{"items": [{"name": "A100_40GB_PCIe_1x", "clusters": ["Hou1", "Msc1"]}]}`)
+	server.Reply("/api/v1/servers/applications/GetConfigurations", http.StatusOK, `{"items": [{"name": "cpu", "clusters": ["Msc1", "Yyz1"]}]}`)
+	server.HandleFunc(
+		"/api/v1/servers/virtual/GetServers",
+		func(resp http.ResponseWriter, req *http.Request) {
+			cluster := req.URL.Query().Get("Cluster")
+			if cluster == "Hou1" {
+				resp.WriteHeader(http.StatusServiceUnavailable)
+				resp.Write([]byte(`{"success": false, "error": {"code": 503, "message": "cluster unavailable"}}`))
+				return
+			}
+			resp.Write([]byte(fmt.Sprintf(`{"items": [{"id": "vm-%s", "cluster": "%s"}]}`, cluster, cluster)))
+		},
+	)
+	server.Reply("/api/v1/servers/applications/GetApplications", http.StatusOK, `{"items": [{"id": "app-1", "cluster": "Msc1"}, {"id": "app-2", "cluster": "Yyz1"}]}`)
+	server.HandleFunc(
+		"/api/v1/servers/applications/GetAvailability",
+		func(resp http.ResponseWriter, req *http.Request) {
+			if req.URL.Query().Get("resourcePool") != "on-demand" {
+				resp.Write([]byte(`{"items": []}`))
+				return
+			}
+			cluster := req.URL.Query().Get("cluster")
+			resp.Write([]byte(fmt.Sprintf(`{"items": [{"configuration": "cpu", "cluster": "%s", "available": true}]}`, cluster)))
+		},
+	)
+
+	vc := server.Virtual()
+	ac := server.Applications()
+
+	t.Run(
+		"Clusters",
+		func(t *testing.T) {
+			clusters, err := fanout.Clusters(context.TODO(), vc, ac)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"Hou1", "Msc1", "Yyz1"}, clusters)
+		},
+	)
+
+	t.Run(
+		"ServersPartialFailure",
+		func(t *testing.T) {
+			result, err := fanout.Servers(context.TODO(), vc, fanout.Options{Workers: 1})
+			assert.NoError(t, err)
+			assert.Len(t, result.Items, 1)
+			assert.Equal(t, "Msc1", result.Items[0].Cluster)
+			assert.Equal(t, "vm-Msc1", *result.Items[0].Item.Id)
+			assert.Contains(t, result.Errors, "Hou1")
+			assert.EqualError(t, result.Err(), "Hou1: 503 Service Unavailable - cluster unavailable")
+		},
+	)
+
+	t.Run(
+		"Applications",
+		func(t *testing.T) {
+			result, err := fanout.Applications(context.TODO(), ac, fanout.Options{Clusters: []string{"Yyz1"}})
+			assert.NoError(t, err)
+			assert.NoError(t, result.Err())
+			assert.Len(t, result.Items, 1)
+			assert.Equal(t, "app-2", *result.Items[0].Item.Id)
+		},
+	)
+
+	t.Run(
+		"ApplicationAvailability",
+		func(t *testing.T) {
+			rpool := "on-demand"
+			result, err := fanout.ApplicationAvailability(context.TODO(), ac, &rpool, fanout.Options{})
+			assert.NoError(t, err)
+			assert.NoError(t, result.Err())
+			clusters := []string{}
+			for _, item := range result.Items {
+				clusters = append(clusters, *item.Item.Cluster)
+			}
+			assert.Equal(t, []string{"Msc1", "Yyz1"}, clusters)
+
+			// The resource pool is required, so the client rejects a missing one for every cluster
+			result, err = fanout.ApplicationAvailability(context.TODO(), ac, nil, fanout.Options{})
+			assert.NoError(t, err)
+			assert.Empty(t, result.Items)
+			assert.EqualError(t, result.Err(), "Msc1: value is a nil pointer\nYyz1: value is a nil pointer")
+		},
+	)
+}
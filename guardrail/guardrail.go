@@ -0,0 +1,231 @@
+// Package guardrail provides an opt-in request interceptor which blocks server and application
+// creates that violate a cost or placement policy before they reach the API.
+//
+// Usage:
+//
+//	policy := guardrail.LoadPolicy()
+//	client := virtual.NewClient()
+//	guard := guardrail.NewGuard(policy, &client, nil)
+//	client.RequestEditors = append(client.RequestEditors, guard.Intercept)
+package guardrail
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/config"
+	"github.com/denvrdata/go-denvr/cost"
+	"github.com/denvrdata/go-denvr/result"
+)
+
+// Policy is loaded from the `[guardrails]` section of the config file. Zero values disable a check.
+type Policy struct {
+	// MaxHourlyPrice is the maximum hourly price of a single server or application
+	MaxHourlyPrice float64 `toml:"max_hourly_price"`
+	// MaxFleetHourly is the maximum hourly price of all running resources, including the new one
+	MaxFleetHourly float64 `toml:"max_fleet_hourly"`
+	// Configurations, Clusters and Rpools restrict what can be created
+	Configurations []string `toml:"configurations"`
+	Clusters       []string `toml:"clusters"`
+	Rpools         []string `toml:"rpools"`
+	// MaxPerUser is the maximum number of running resources owned by User
+	MaxPerUser int `toml:"max_per_user"`
+	// User defaults to the credentials username, used to count resources for MaxPerUser
+	User string `toml:"user"`
+}
+
+// LoadPolicy reads the policy from the same config file as config.NewConfig.
+//
+// [guardrails]
+// max_hourly_price = 10.0
+// max_fleet_hourly = 50.0
+// clusters = ["Msc1"]
+// rpools = ["on-demand"]
+// max_per_user = 2
+func LoadPolicy(paths ...string) Policy {
+	path := config.Path(paths...)
+
+	var content struct {
+		Guardrails  Policy `toml:"guardrails"`
+		Credentials struct {
+			Username string `toml:"username"`
+		} `toml:"credentials"`
+	}
+	result.Wrap(toml.DecodeFile(path, &content)).Unwrap()
+
+	policy := content.Guardrails
+	if policy.User == "" {
+		policy.User = os.Getenv("DENVR_USERNAME")
+	}
+	if policy.User == "" {
+		policy.User = content.Credentials.Username
+	}
+	return policy
+}
+
+// Violation is returned from the intercepted client call when a create is blocked.
+type Violation struct {
+	Operation string
+	Target    string
+	Reasons   []string
+}
+
+func (v *Violation) Error() string {
+	return fmt.Sprintf("guardrail blocked %s %s: %s", v.Operation, v.Target, strings.Join(v.Reasons, "; "))
+}
+
+// Guard checks create requests against a Policy, using the Estimator for prices and the current fleet.
+type Guard struct {
+	Policy    Policy
+	Estimator *cost.Estimator
+}
+
+// NewGuard creates a Guard which prices requests using the provided clients. Either client may be nil
+// if the guard is only used with the other service, but fleet wide checks only see the services provided.
+func NewGuard(policy Policy, vc virtual.ClientInterface, ac applications.ClientInterface) *Guard {
+	return &Guard{policy, &cost.Estimator{Servers: vc, Applications: ac}}
+}
+
+// Intercept matches the RequestEditorFn signature of the generated clients.
+// Only CreateServer, CreateCatalogApplication and CreateCustomApplication requests are checked.
+func (g *Guard) Intercept(ctx context.Context, req *http.Request) error {
+	var operation string
+	switch {
+	case strings.HasSuffix(req.URL.Path, "/servers/virtual/CreateServer"):
+		operation = "CreateServer"
+	case strings.HasSuffix(req.URL.Path, "/servers/applications/CreateCatalogApplication"):
+		operation = "CreateCatalogApplication"
+	case strings.HasSuffix(req.URL.Path, "/servers/applications/CreateCustomApplication"):
+		operation = "CreateCustomApplication"
+	default:
+		return nil
+	}
+
+	body, err := readBody(req)
+	if err != nil {
+		return err
+	}
+
+	var est cost.Estimate
+	switch operation {
+	case "CreateServer":
+		var input virtual.CreateVirtualServerInput
+		if err := json.Unmarshal(body, &input); err != nil {
+			return err
+		}
+		est, err = g.Estimator.Server(ctx, input)
+	case "CreateCatalogApplication":
+		var input applications.ApplicationsApiCreateRequest
+		if err := json.Unmarshal(body, &input); err != nil {
+			return err
+		}
+		est, err = g.Estimator.CatalogApplication(ctx, input)
+	case "CreateCustomApplication":
+		var input applications.ApplicationsApiCustomApiCreateRequest
+		if err := json.Unmarshal(body, &input); err != nil {
+			return err
+		}
+		est, err = g.Estimator.CustomApplication(ctx, input)
+	}
+	if err != nil {
+		return fmt.Errorf("guardrail failed to price %s: %w", operation, err)
+	}
+
+	return g.Check(ctx, operation, est)
+}
+
+// Check validates an estimate against the policy, returning a *Violation listing every failed rule.
+func (g *Guard) Check(ctx context.Context, operation string, est cost.Estimate) error {
+	p := g.Policy
+	var reasons []string
+
+	if len(p.Configurations) > 0 && !slices.Contains(p.Configurations, est.Configuration) {
+		reasons = append(reasons, fmt.Sprintf("configuration %q is not in the allowed configurations %v", est.Configuration, p.Configurations))
+	}
+	if len(p.Clusters) > 0 && !slices.Contains(p.Clusters, est.Cluster) {
+		reasons = append(reasons, fmt.Sprintf("cluster %q is not in the allowed clusters %v", est.Cluster, p.Clusters))
+	}
+	if len(p.Rpools) > 0 && !slices.Contains(p.Rpools, est.Rpool) {
+		if est.Rpool == "" {
+			reasons = append(reasons, fmt.Sprintf("an explicit rpool from %v is required", p.Rpools))
+		} else {
+			reasons = append(reasons, fmt.Sprintf("rpool %q is not in the allowed rpools %v", est.Rpool, p.Rpools))
+		}
+	}
+	if p.MaxHourlyPrice > 0 && est.Hourly > p.MaxHourlyPrice {
+		reasons = append(reasons, fmt.Sprintf("hourly price $%.2f exceeds the maximum of $%.2f", est.Hourly, p.MaxHourlyPrice))
+	}
+
+	if p.MaxFleetHourly > 0 || p.MaxPerUser > 0 {
+		fleet, err := g.Estimator.Fleet(ctx)
+		if err != nil {
+			return fmt.Errorf("guardrail failed to price the current fleet: %w", err)
+		}
+		if total := fleet.Hourly + est.Hourly; p.MaxFleetHourly > 0 && total > p.MaxFleetHourly {
+			reasons = append(
+				reasons,
+				fmt.Sprintf("fleet hourly cost would be $%.2f, exceeding the maximum of $%.2f", total, p.MaxFleetHourly),
+			)
+		}
+		if p.MaxPerUser > 0 && p.User == "" {
+			reasons = append(reasons, "max_per_user requires a user to be configured")
+		} else if p.MaxPerUser > 0 {
+			count := 0
+			for _, item := range fleet.Items {
+				if strings.EqualFold(item.User, p.User) {
+					count++
+				}
+			}
+			// Unpriced resources are still running
+			for _, item := range fleet.Unpriced {
+				if strings.EqualFold(item.User, p.User) {
+					count++
+				}
+			}
+			if count >= p.MaxPerUser {
+				reasons = append(
+					reasons,
+					fmt.Sprintf("user %q already has %d running resource(s), the maximum is %d", p.User, count, p.MaxPerUser),
+				)
+			}
+		}
+	}
+
+	if len(reasons) > 0 {
+		return &Violation{operation, fmt.Sprintf("%s/%s", est.Cluster, est.Name), reasons}
+	}
+	return nil
+}
+
+// readBody returns the request body while leaving it readable for the actual request.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
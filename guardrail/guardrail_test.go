@@ -0,0 +1,155 @@
+package guardrail_test
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/denvrtest"
+	"github.com/denvrdata/go-denvr/guardrail"
+	"github.com/denvrdata/go-denvr/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func TestLoadPolicy(t *testing.T) {
+	content := `[defaults]
+        tenant = "denvr"
+
+        [credentials]
+        username = "test@foobar.com"
+        password = "test.foo.bar.baz"
+
+        [guardrails]
+        max_hourly_price = 10.0
+        max_fleet_hourly = 25.5
+        configurations = ["A100_40GB_PCIe_1x"]
+        clusters = ["Msc1", "Hou1"]
+        rpools = ["on-demand"]
+        max_per_user = 2`
+
+	f := result.Wrap(os.CreateTemp("", "test-guardrail-tmpfile-")).Unwrap()
+	defer f.Close()
+	defer os.Remove(f.Name())
+	result.Wrap(f.Write([]byte(content))).Unwrap()
+
+	os.Unsetenv("DENVR_USERNAME")
+	policy := guardrail.LoadPolicy(f.Name())
+	assert.Equal(
+		t,
+		guardrail.Policy{
+			MaxHourlyPrice: 10,
+			MaxFleetHourly: 25.5,
+			Configurations: []string{"A100_40GB_PCIe_1x"},
+			Clusters:       []string{"Msc1", "Hou1"},
+			Rpools:         []string{"on-demand"},
+			MaxPerUser:     2,
+			User:           "test@foobar.com",
+		},
+		policy,
+	)
+}
+
+func TestGuard(t *testing.T) {
+	created := 0
+	server := denvrtest.NewServer(t)
+	server.Reply("/api/v1/servers/virtual/GetConfigurations", http.StatusOK, `{"items": [{"name": "A100_40GB_PCIe_1x", "price": 2.05}, {"name": "H100_80GB_SXM_8x", "price": 20.0}]}`)
+	server.Reply("/api/v1/servers/virtual/GetAvailability", http.StatusOK, `{"items": []}`)
+	server.Reply(
+		"/api/v1/servers/virtual/GetServers",
+		http.StatusOK,
+		`{
+			"items": [
+				{"id": "a", "cluster": "Msc1", "rpool": "on-demand", "configuration": "A100_40GB_PCIe_1x", "username": "alice", "status": "ONLINE"},
+				{"id": "b", "cluster": "Msc1", "rpool": "on-demand", "configuration": "A100_40GB_PCIe_1x", "username": "bob", "status": "ONLINE"}
+			]
+		}`,
+	)
+	server.HandleFunc(
+		"/api/v1/servers/virtual/CreateServer",
+		func(resp http.ResponseWriter, req *http.Request) {
+			created++
+			resp.Write([]byte(`{"id": "new", "cluster": "Msc1"}`))
+		},
+	)
+	server.Reply("/api/v1/servers/applications/GetConfigurations", http.StatusOK, `{"items": [{"name": "cpu-4vcpu", "pricePerHour": 0.25}]}`)
+	server.Reply("/api/v1/servers/applications/GetApplications", http.StatusOK, `{"items": []}`)
+	server.Reply("/api/v1/servers/applications/GetAvailability", http.StatusOK, `{"items": []}`)
+
+	vc := server.Virtual()
+	ac := server.Applications()
+	guard := guardrail.NewGuard(
+		guardrail.Policy{
+			MaxHourlyPrice: 10,
+			MaxFleetHourly: 8,
+			Clusters:       []string{"Msc1"},
+			Rpools:         []string{"on-demand"},
+			MaxPerUser:     1,
+			User:           "carol",
+		},
+		vc,
+		ac,
+	)
+	vc.RequestEditors = append(vc.RequestEditors, guard.Intercept)
+	ac.RequestEditors = append(ac.RequestEditors, guard.Intercept)
+
+	t.Run(
+		"Allowed",
+		func(t *testing.T) {
+			vm, err := vc.CreateServer(
+				context.TODO(),
+				virtual.CreateVirtualServerInput{Cluster: "Msc1", Rpool: ptr("on-demand"), Configuration: "A100_40GB_PCIe_1x", Name: ptr("new")},
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, "new", *vm.Id)
+			assert.Equal(t, 1, created)
+		},
+	)
+
+	t.Run(
+		"Blocked",
+		func(t *testing.T) {
+			_, err := vc.CreateServer(
+				context.TODO(),
+				virtual.CreateVirtualServerInput{Cluster: "Hou1", Rpool: ptr("reserved-denvr"), Configuration: "H100_80GB_SXM_8x", Name: ptr("big")},
+			)
+			var violation *guardrail.Violation
+			assert.ErrorAs(t, err, &violation)
+			assert.Equal(t, "CreateServer", violation.Operation)
+			assert.Equal(t, "Hou1/big", violation.Target)
+			assert.Equal(
+				t,
+				[]string{
+					`cluster "Hou1" is not in the allowed clusters [Msc1]`,
+					`rpool "reserved-denvr" is not in the allowed rpools [on-demand]`,
+					"hourly price $20.00 exceeds the maximum of $10.00",
+					"fleet hourly cost would be $24.10, exceeding the maximum of $8.00",
+				},
+				violation.Reasons,
+			)
+			assert.Equal(t, 1, created)
+		},
+	)
+
+	t.Run(
+		"MaxPerUser",
+		func(t *testing.T) {
+			guard.Policy.User = "alice"
+			defer func() { guard.Policy.User = "carol" }()
+
+			_, err := ac.CreateCatalogApplication(
+				context.TODO(),
+				applications.ApplicationsApiCreateRequest{Cluster: "Msc1", ResourcePool: ptr("on-demand"), HardwarePackageName: "cpu-4vcpu", Name: "notebook"},
+			)
+			assert.EqualError(
+				t,
+				err,
+				`guardrail blocked CreateCatalogApplication Msc1/notebook: user "alice" already has 1 running resource(s), the maximum is 1`,
+			)
+		},
+	)
+}
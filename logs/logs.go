@@ -0,0 +1,193 @@
+// Package logs follows server boot logs and application runtime logs.
+//
+// The log endpoints only return the most recent lines, so we poll them and
+// drop the lines which overlap with the previous response.
+//
+// Usage:
+//
+//	f := logs.ServerFollower(&client, virtual.GetVirtualMachineBootLogsParams{Id: "my-vm", Namespace: "denvr", Cluster: "Msc1"})
+//	r := f.Follow(ctx)
+//	defer r.Close()
+//	io.Copy(os.Stdout, r)
+package logs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/response"
+	"github.com/denvrdata/go-denvr/waiter"
+)
+
+// Source fetches the current logs and reports whether the resource has finished,
+// in which case no further logs are expected after these.
+// Sources return an error wrapping ErrNotFound if the resource doesn't exist.
+type Source func(ctx context.Context) (logs string, done bool, err error)
+
+// ErrNotFound is returned when the server or application doesn't exist.
+// Lines and Follow only return it if the resource was never found, rather than removed while following.
+var ErrNotFound = errors.New("not found")
+
+// Follower polls a Source for new lines.
+type Follower struct {
+	Source Source
+	// Interval between polls (defaults to 5 seconds)
+	Interval time.Duration
+}
+
+// ServerFollower follows the boot logs of a server until it is ONLINE, fails or is removed.
+func ServerFollower(client virtual.ClientInterface, params virtual.GetVirtualMachineBootLogsParams) *Follower {
+	return &Follower{
+		Source: func(ctx context.Context) (string, bool, error) {
+			// We check the status first, so the final fetch includes everything logged before the status changed.
+			server, err := client.GetServer(
+				ctx,
+				&virtual.GetServerParams{Id: params.Id, Namespace: params.Namespace, Cluster: params.Cluster},
+			)
+			if response.IsStatus(err, http.StatusNotFound) {
+				return "", true, fmt.Errorf("server %s/%s %w", params.Cluster, params.Id, ErrNotFound)
+			} else if err != nil {
+				return "", false, err
+			}
+			status := server.GetStatus()
+			done := status == "ONLINE" || slices.Contains(waiter.FailedStatuses, status)
+
+			rsp, err := client.GetVirtualMachineBootLogs(ctx, &params)
+			if err != nil {
+				return "", done, err
+			}
+			return rsp.GetBootLogs(), done, nil
+		},
+	}
+}
+
+// ApplicationFollower follows the runtime logs of an application until it stops, fails or is removed.
+func ApplicationFollower(client applications.ClientInterface, params applications.GetApplicationRuntimeLogsParams) *Follower {
+	return &Follower{
+		Source: func(ctx context.Context) (string, bool, error) {
+			app, err := client.GetApplicationDetails(
+				ctx,
+				&applications.GetApplicationDetailsParams{Id: params.Id, Cluster: params.Cluster},
+			)
+			if response.IsStatus(err, http.StatusNotFound) {
+				return "", true, fmt.Errorf("application %s/%s %w", params.Cluster, params.Id, ErrNotFound)
+			} else if err != nil {
+				return "", false, err
+			}
+			var status string
+			if app.InstanceDetails != nil {
+				status = app.InstanceDetails.GetStatus()
+			}
+			done := status == "OFFLINE" || slices.Contains(waiter.FailedStatuses, status)
+
+			rsp, err := client.GetApplicationRuntimeLogs(ctx, &params)
+			if err != nil {
+				return "", done, err
+			}
+			return rsp.GetLogs(), done, nil
+		},
+	}
+}
+
+// Lines yields each new line until the resource finishes, an error occurs or the context is cancelled.
+// Context cancellation and the resource being removed after it was found end the sequence without an error.
+func (f *Follower) Lines(ctx context.Context) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		var seen []string
+		stopped, found := false, false
+		err := waiter.Poll(
+			ctx,
+			waiter.Options{Interval: f.interval()},
+			func(ctx context.Context) (bool, error) {
+				logs, done, err := f.Source(ctx)
+				if found && errors.Is(err, ErrNotFound) {
+					return true, nil
+				}
+				if err != nil {
+					return false, err
+				}
+				found = true
+				current := split(logs)
+				for _, line := range Unseen(seen, current) {
+					if !yield(line, nil) {
+						stopped = true
+						return true, nil
+					}
+				}
+				seen = current
+				return done, nil
+			},
+		)
+		if err != nil && ctx.Err() == nil && !stopped {
+			yield("", err)
+		}
+	}
+}
+
+// Follow returns a reader of the new lines, each terminated with a newline.
+// Reads return io.EOF once the resource finishes or the context is cancelled.
+// Closing the reader stops polling.
+func (f *Follower) Follow(ctx context.Context) io.ReadCloser {
+	ctx, cancel := context.WithCancel(ctx)
+	r, w := io.Pipe()
+	go func() {
+		defer cancel()
+		for line, err := range f.Lines(ctx) {
+			if err == nil {
+				_, err = io.WriteString(w, line+"\n")
+			}
+			if err != nil {
+				w.CloseWithError(err)
+				return
+			}
+		}
+		w.Close()
+	}()
+	return &reader{r, cancel}
+}
+
+func (f *Follower) interval() time.Duration {
+	if f.Interval <= 0 {
+		return 5 * time.Second
+	}
+	return f.Interval
+}
+
+// reader cancels polling when closed.
+type reader struct {
+	*io.PipeReader
+	cancel context.CancelFunc
+}
+
+func (r *reader) Close() error {
+	r.cancel()
+	return r.PipeReader.Close()
+}
+
+// Unseen returns the lines in current which follow the longest overlap between the end of previous and
+// the start of current. If nothing overlaps, every line in current is considered new.
+func Unseen(previous, current []string) []string {
+	for k := min(len(previous), len(current)); k > 0; k-- {
+		if slices.Equal(previous[len(previous)-k:], current[:k]) {
+			return current[k:]
+		}
+	}
+	return current
+}
+
+func split(logs string) []string {
+	logs = strings.TrimRight(logs, "\r\n")
+	if logs == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(logs, "\r\n", "\n"), "\n")
+}
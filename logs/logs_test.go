@@ -0,0 +1,152 @@
+package logs_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/denvrtest"
+	"github.com/denvrdata/go-denvr/logs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnseen(t *testing.T) {
+	for _, tc := range []struct {
+		previous []string
+		current  []string
+		expected []string
+	}{
+		{nil, []string{"a", "b"}, []string{"a", "b"}},
+		{[]string{"a", "b"}, []string{"a", "b"}, []string{}},
+		{[]string{"a", "b"}, []string{"a", "b", "c"}, []string{"c"}},
+		{[]string{"a", "b", "c"}, []string{"b", "c", "d", "e"}, []string{"d", "e"}},
+		{[]string{"x", "x"}, []string{"x", "x", "x"}, []string{"x"}},
+		{[]string{"a", "b"}, []string{"c", "d"}, []string{"c", "d"}},
+	} {
+		assert.Equal(t, tc.expected, logs.Unseen(tc.previous, tc.current), "%v -> %v", tc.previous, tc.current)
+	}
+}
+
+// fakeLogs serves a growing log file, whose window only includes the last 3 lines.
+type fakeLogs struct {
+	sync.Mutex
+	lines  []string
+	status string
+	polls  int
+}
+
+func (f *fakeLogs) poll() string {
+	f.Lock()
+	defer f.Unlock()
+	f.polls++
+	f.lines = append(f.lines, "line "+string(rune('0'+f.polls)))
+	if f.polls == 2 {
+		// Two lines logged between polls
+		f.lines = append(f.lines, "extra")
+	}
+	if f.polls == 4 {
+		f.status = "ONLINE"
+	}
+	return strings.Join(f.lines[max(0, len(f.lines)-3):], "\n")
+}
+
+func TestServerFollower(t *testing.T) {
+	f := &fakeLogs{status: "PENDING"}
+	server := denvrtest.NewServer(t)
+	server.HandleFunc(
+		"/api/v1/servers/virtual/GetServer",
+		func(resp http.ResponseWriter, req *http.Request) {
+			f.Lock()
+			defer f.Unlock()
+			json.NewEncoder(resp).Encode(map[string]string{"id": "vm", "status": f.status})
+		},
+	)
+	server.HandleFunc(
+		"/api/v1/servers/virtual/GetVirtualMachineBootLogs",
+		func(resp http.ResponseWriter, req *http.Request) {
+			json.NewEncoder(resp).Encode(map[string]string{"id": "vm", "bootLogs": f.poll()})
+		},
+	)
+	follower := logs.ServerFollower(
+		server.Virtual(),
+		virtual.GetVirtualMachineBootLogsParams{Id: "vm", Namespace: "denvr", Cluster: "Msc1"},
+	)
+	follower.Interval = time.Millisecond
+
+	r := follower.Follow(context.TODO())
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	// The status is checked before the logs, so we keep polling once more after ONLINE
+	assert.Equal(t, "line 1\nline 2\nextra\nline 3\nline 4\nline 5\n", string(data))
+}
+
+func TestApplicationFollower(t *testing.T) {
+	polls := 0
+	server := denvrtest.NewServer(t)
+	server.HandleFunc(
+		"/api/v1/servers/applications/GetApplicationDetails",
+		func(resp http.ResponseWriter, req *http.Request) {
+			polls++
+			if polls > 2 {
+				resp.WriteHeader(http.StatusNotFound)
+				resp.Write([]byte(`{"error": {"message": "not found"}}`))
+				return
+			}
+			resp.Write([]byte(`{"instanceDetails": {"id": "app", "status": "ONLINE"}}`))
+		},
+	)
+	server.Reply("/api/v1/servers/applications/GetApplicationRuntimeLogs", http.StatusOK, `{"id": "app", "logs": "started\nserving\n"}`)
+	follower := logs.ApplicationFollower(
+		server.Applications(),
+		applications.GetApplicationRuntimeLogsParams{Id: "app", Cluster: "Msc1", Limit: 100},
+	)
+	follower.Interval = time.Millisecond
+
+	var lines []string
+	for line, err := range follower.Lines(context.TODO()) {
+		assert.NoError(t, err)
+		lines = append(lines, line)
+	}
+	assert.Equal(t, []string{"started", "serving"}, lines)
+	assert.Equal(t, 3, polls)
+
+	// Resources which never existed are an error rather than empty logs
+	_, _, err := follower.Source(context.TODO())
+	assert.ErrorIs(t, err, logs.ErrNotFound)
+	assert.EqualError(t, err, "application Msc1/app not found")
+	_, err = io.ReadAll(follower.Follow(context.TODO()))
+	assert.ErrorIs(t, err, logs.ErrNotFound)
+}
+
+func TestFollowerError(t *testing.T) {
+	follower := &logs.Follower{
+		Source: func(ctx context.Context) (string, bool, error) {
+			return "", false, errors.New("boom")
+		},
+		Interval: time.Millisecond,
+	}
+	_, err := io.ReadAll(follower.Follow(context.TODO()))
+	assert.EqualError(t, err, "boom")
+
+	// Breaking early stops polling without an error
+	calls := 0
+	follower.Source = func(ctx context.Context) (string, bool, error) {
+		calls++
+		return "a\nb\nc", false, nil
+	}
+	for line, err := range follower.Lines(context.TODO()) {
+		assert.NoError(t, err)
+		assert.Equal(t, "a", line)
+		break
+	}
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,186 @@
+package logs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/denvrdata/go-denvr/waiter"
+)
+
+// Record is a single log entry. Lines which start with whitespace (e.g., stack traces)
+// are folded into the preceding record.
+type Record struct {
+	// Time is zero if the entry doesn't start with a recognized timestamp
+	Time time.Time
+	// Level is normalized to TRACE, DEBUG, INFO, WARN, ERROR or FATAL, and empty if it couldn't be detected
+	Level string
+	// Message is the text following the timestamp
+	Message string
+	// Text is the original text of the entry
+	Text string
+}
+
+var (
+	timestampPattern = regexp.MustCompile(
+		`^\[?(\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(?:[.,]\d+)?(?:Z|[+-]\d{2}:?\d{2})?)\]?\s*`,
+	)
+	levelPattern    = regexp.MustCompile(`\b(TRACE|DEBUG|INFO|NOTICE|WARN|WARNING|ERROR|ERR|FATAL|CRITICAL|PANIC)\b`)
+	levelKeyPattern = regexp.MustCompile(`(?i)\blevel=["']?(\w+)`)
+	levels          = map[string]string{
+		"TRACE":    "TRACE",
+		"DEBUG":    "DEBUG",
+		"INFO":     "INFO",
+		"NOTICE":   "INFO",
+		"WARN":     "WARN",
+		"WARNING":  "WARN",
+		"ERROR":    "ERROR",
+		"ERR":      "ERROR",
+		"FATAL":    "FATAL",
+		"CRITICAL": "FATAL",
+		"PANIC":    "FATAL",
+	}
+	timestampLayouts = []string{
+		"2006-01-02T15:04:05.999999999Z07:00",
+		"2006-01-02T15:04:05.999999999Z0700",
+		"2006-01-02T15:04:05.999999999",
+	}
+)
+
+// Parse splits logs into records, detecting leading timestamps (assumed to be UTC without a time zone) and levels.
+func Parse(logs string) []Record {
+	var records []Record
+	for _, line := range split(logs) {
+		if len(records) > 0 && line != "" && strings.TrimLeft(line, " \t") != line {
+			last := &records[len(records)-1]
+			last.Message += "\n" + line
+			last.Text += "\n" + line
+			continue
+		}
+		records = append(records, parseLine(line))
+	}
+	return records
+}
+
+func parseLine(line string) Record {
+	r := Record{Message: line, Text: line}
+	if m := timestampPattern.FindStringSubmatchIndex(line); m != nil {
+		stamp := strings.Replace(strings.Replace(line[m[2]:m[3]], " ", "T", 1), ",", ".", 1)
+		for _, layout := range timestampLayouts {
+			if t, err := time.Parse(layout, stamp); err == nil {
+				r.Time = t
+				r.Message = line[m[1]:]
+				break
+			}
+		}
+	}
+
+	if m := levelKeyPattern.FindStringSubmatch(r.Message); m != nil {
+		r.Level = levels[strings.ToUpper(m[1])]
+	}
+	if r.Level == "" {
+		if m := levelPattern.FindStringSubmatch(r.Message); m != nil {
+			r.Level = levels[m[1]]
+		}
+	}
+	return r
+}
+
+// Filter selects records. Zero values match everything.
+type Filter struct {
+	// Pattern is matched against the record text
+	Pattern *regexp.Regexp
+	// Levels are matched case-insensitively against the normalized record level (e.g., "WARN", "ERROR")
+	Levels []string
+	// Since and Until bound the record time (inclusive). Records without a timestamp never match a time window.
+	Since time.Time
+	Until time.Time
+}
+
+// Match reports whether the record satisfies every condition of the filter.
+func (f Filter) Match(r Record) bool {
+	if f.Pattern != nil && !f.Pattern.MatchString(r.Text) {
+		return false
+	}
+	if len(f.Levels) > 0 && !slices.ContainsFunc(f.Levels, func(l string) bool { return strings.EqualFold(l, r.Level) }) {
+		return false
+	}
+	if !f.Since.IsZero() && (r.Time.IsZero() || r.Time.Before(f.Since)) {
+		return false
+	}
+	if !f.Until.IsZero() && (r.Time.IsZero() || r.Time.After(f.Until)) {
+		return false
+	}
+	return true
+}
+
+// Apply returns the matching records.
+func (f Filter) Apply(records []Record) []Record {
+	var matched []Record
+	for _, r := range records {
+		if f.Match(r) {
+			matched = append(matched, r)
+		}
+	}
+	return matched
+}
+
+// WriteText writes the original text of each record, one per line.
+func WriteText(w io.Writer, records []Record) error {
+	for _, r := range records {
+		if _, err := fmt.Fprintln(w, r.Text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteJSONL writes each record as a JSON object per line, omitting unknown times and levels.
+func WriteJSONL(w io.Writer, records []Record) error {
+	enc := json.NewEncoder(w)
+	for _, r := range records {
+		entry := struct {
+			Time    *time.Time `json:"time,omitempty"`
+			Level   string     `json:"level,omitempty"`
+			Message string     `json:"message"`
+		}{Level: r.Level, Message: r.Message}
+		if !r.Time.IsZero() {
+			entry.Time = &r.Time
+		}
+		if err := enc.Encode(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Export writes the records to path, as JSON Lines if the extension is .jsonl or .ndjson and as text otherwise.
+func Export(path string, records []Record) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".jsonl", ".ndjson":
+		err = WriteJSONL(f, records)
+	default:
+		err = WriteText(f, records)
+	}
+	return errors.Join(err, f.Close())
+}
+
+// Snapshot returns the records of the logs captured when a waiter saw a server or application fail.
+func Snapshot(err error) ([]Record, bool) {
+	var ferr *waiter.FailedError
+	if !errors.As(err, &ferr) || ferr.Logs == "" {
+		return nil, false
+	}
+	return Parse(ferr.Logs), true
+}
@@ -0,0 +1,122 @@
+package logs_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/denvrdata/go-denvr/logs"
+	"github.com/denvrdata/go-denvr/waiter"
+	"github.com/stretchr/testify/assert"
+)
+
+const bootLogs = `[  OK  ] Started Journal Service.
+2024-11-05T13:30:00Z cloud-init[812]: INFO: Running modules for config
+2024-11-05 13:30:01,250 - util.py[WARNING]: Failed to resolve mirror
+2024-11-05T08:30:02.5-05:00 level=error msg="nvidia driver failed to load"
+Traceback (most recent call last):
+  File "/usr/bin/cloud-init", line 11, in <module>
+    main()
+2024-11-05T13:30:03+0000 FATAL: giving up
+`
+
+func TestParse(t *testing.T) {
+	records := logs.Parse(bootLogs)
+	assert.Len(t, records, 6)
+
+	assert.True(t, records[0].Time.IsZero())
+	assert.Equal(t, "", records[0].Level)
+	assert.Equal(t, "[  OK  ] Started Journal Service.", records[0].Message)
+
+	assert.Equal(t, time.Date(2024, 11, 5, 13, 30, 0, 0, time.UTC), records[1].Time)
+	assert.Equal(t, "INFO", records[1].Level)
+	assert.Equal(t, "cloud-init[812]: INFO: Running modules for config", records[1].Message)
+
+	assert.Equal(t, time.Date(2024, 11, 5, 13, 30, 1, 250000000, time.UTC), records[2].Time)
+	assert.Equal(t, "WARN", records[2].Level)
+
+	assert.True(t, time.Date(2024, 11, 5, 13, 30, 2, 500000000, time.UTC).Equal(records[3].Time))
+	assert.Equal(t, "ERROR", records[3].Level)
+
+	assert.True(t, records[4].Time.IsZero())
+	assert.Equal(
+		t,
+		"Traceback (most recent call last):\n  File \"/usr/bin/cloud-init\", line 11, in <module>\n    main()",
+		records[4].Text,
+	)
+
+	assert.Equal(t, "FATAL", records[5].Level)
+	assert.Equal(t, "FATAL: giving up", records[5].Message)
+}
+
+func TestFilter(t *testing.T) {
+	records := logs.Parse(bootLogs)
+
+	texts := func(records []logs.Record) []string {
+		var texts []string
+		for _, r := range records {
+			texts = append(texts, r.Message)
+		}
+		return texts
+	}
+
+	assert.Len(t, logs.Filter{}.Apply(records), 6)
+	assert.Equal(
+		t,
+		[]string{`level=error msg="nvidia driver failed to load"`, "FATAL: giving up"},
+		texts(logs.Filter{Levels: []string{"error", "FATAL"}}.Apply(records)),
+	)
+	assert.Equal(
+		t,
+		[]string{"- util.py[WARNING]: Failed to resolve mirror", `level=error msg="nvidia driver failed to load"`},
+		texts(logs.Filter{Pattern: regexp.MustCompile(`(?i)fail`)}.Apply(records)),
+	)
+	assert.Equal(
+		t,
+		[]string{`level=error msg="nvidia driver failed to load"`},
+		texts(
+			logs.Filter{
+				Since: time.Date(2024, 11, 5, 13, 30, 2, 0, time.UTC),
+				Until: time.Date(2024, 11, 5, 13, 30, 2, 999999999, time.UTC),
+			}.Apply(records),
+		),
+	)
+}
+
+func TestExport(t *testing.T) {
+	records := logs.Filter{Levels: []string{"INFO", "ERROR"}}.Apply(logs.Parse(bootLogs))
+
+	var buf bytes.Buffer
+	assert.NoError(t, logs.WriteJSONL(&buf, records))
+	assert.Equal(
+		t,
+		`{"time":"2024-11-05T13:30:00Z","level":"INFO","message":"cloud-init[812]: INFO: Running modules for config"}
+{"time":"2024-11-05T08:30:02.5-05:00","level":"ERROR","message":"level=error msg=\"nvidia driver failed to load\""}
+`,
+		buf.String(),
+	)
+
+	path := filepath.Join(t.TempDir(), "boot.log")
+	assert.NoError(t, logs.Export(path, logs.Parse("a\nb")))
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "a\nb\n", string(data))
+
+	path = filepath.Join(t.TempDir(), "boot.jsonl")
+	assert.NoError(t, logs.Export(path, logs.Parse("a")))
+	data, err = os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "{\"message\":\"a\"}\n", string(data))
+}
+
+func TestSnapshot(t *testing.T) {
+	_, ok := logs.Snapshot(&waiter.FailedError{Kind: "server", Name: "vm", Status: "FAILED"})
+	assert.False(t, ok)
+
+	records, ok := logs.Snapshot(&waiter.FailedError{Kind: "server", Name: "vm", Status: "FAILED", Logs: bootLogs})
+	assert.True(t, ok)
+	assert.Len(t, records, 6)
+}
@@ -0,0 +1,183 @@
+package placement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/response"
+)
+
+type EventType string
+
+const (
+	// Polled is emitted after each availability check with the number of candidates found.
+	// Err is set if the check failed for some or all clusters, in which case we keep polling.
+	Polled EventType = "polled"
+	// Attempting is emitted before trying to create the resource on a candidate.
+	Attempting EventType = "attempting"
+	// Conflict is emitted when a create fails because the capacity was taken.
+	Conflict EventType = "conflict"
+	// Waiting is emitted before sleeping until the next availability check.
+	Waiting EventType = "waiting"
+	// Created is emitted once the resource has been created.
+	Created EventType = "created"
+)
+
+// Event reports the progress of a launch.
+type Event struct {
+	Type       EventType
+	Attempt    int
+	Candidates int
+	Candidate  *Candidate
+	Delay      time.Duration
+	Err        error
+}
+
+// LaunchOptions controls how long and how often we poll for capacity.
+type LaunchOptions struct {
+	Constraints Constraints
+	// Deadline to give up by. If zero, we wait until the context is cancelled.
+	Deadline time.Time
+	// MinInterval is the initial delay between availability checks (defaults to 15 seconds)
+	MinInterval time.Duration
+	// MaxInterval caps the exponential backoff between availability checks (defaults to 5 minutes)
+	MaxInterval time.Duration
+	// OnEvent is called synchronously with progress updates if set
+	OnEvent func(Event)
+}
+
+// capacityPattern matches the error messages of creates rejected for lack of capacity
+// (e.g., "Insufficient capacity" or "Not enough GPUs available on the node").
+var capacityPattern = regexp.MustCompile(`(?i)\bcapacity\b|\b(insufficient|not enough) (resources|gpus?|nodes?)\b`)
+
+// IsCapacityError reports whether a create was rejected because the requested capacity is no longer available.
+// Other conflicts (e.g., a resource with the same name already exists) and server errors aren't capacity errors,
+// as retrying them on another candidate could create a duplicate resource.
+func IsCapacityError(err error) bool {
+	var serr *response.StatusError
+	if !errors.As(err, &serr) {
+		return false
+	}
+	if serr.StatusCode < http.StatusBadRequest || serr.StatusCode >= http.StatusInternalServerError {
+		return false
+	}
+	return capacityPattern.MatchString(serr.Message)
+}
+
+// LaunchServerWhenAvailable polls availability until a candidate matching the constraints appears
+// and creates the server there, trying the next candidate when capacity was taken in the meantime.
+func LaunchServerWhenAvailable(
+	ctx context.Context,
+	vc virtual.ClientInterface,
+	input virtual.CreateVirtualServerInput,
+	opts LaunchOptions,
+) (*virtual.VirtualServerDetailsItem, error) {
+	return launch(
+		ctx,
+		opts,
+		func(ctx context.Context) ([]Candidate, error) { return Servers(ctx, vc, opts.Constraints) },
+		func(ctx context.Context, c Candidate) (*virtual.VirtualServerDetailsItem, error) {
+			return vc.CreateServer(ctx, c.ServerInput(input))
+		},
+	)
+}
+
+// LaunchCatalogApplicationWhenAvailable is LaunchServerWhenAvailable for catalog applications.
+func LaunchCatalogApplicationWhenAvailable(
+	ctx context.Context,
+	ac applications.ClientInterface,
+	req applications.ApplicationsApiCreateRequest,
+	opts LaunchOptions,
+) (*applications.ApplicationsApiOverview, error) {
+	return launch(
+		ctx,
+		opts,
+		func(ctx context.Context) ([]Candidate, error) { return Applications(ctx, ac, opts.Constraints) },
+		func(ctx context.Context, c Candidate) (*applications.ApplicationsApiOverview, error) {
+			return ac.CreateCatalogApplication(ctx, c.CatalogRequest(req))
+		},
+	)
+}
+
+// LaunchCustomApplicationWhenAvailable is LaunchServerWhenAvailable for custom applications.
+func LaunchCustomApplicationWhenAvailable(
+	ctx context.Context,
+	ac applications.ClientInterface,
+	req applications.ApplicationsApiCustomApiCreateRequest,
+	opts LaunchOptions,
+) (*applications.ApplicationsApiOverview, error) {
+	return launch(
+		ctx,
+		opts,
+		func(ctx context.Context) ([]Candidate, error) { return Applications(ctx, ac, opts.Constraints) },
+		func(ctx context.Context, c Candidate) (*applications.ApplicationsApiOverview, error) {
+			return ac.CreateCustomApplication(ctx, c.CustomRequest(req))
+		},
+	)
+}
+
+func launch[T any](
+	ctx context.Context,
+	opts LaunchOptions,
+	find func(ctx context.Context) ([]Candidate, error),
+	create func(ctx context.Context, c Candidate) (*T, error),
+) (*T, error) {
+	if !opts.Deadline.IsZero() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, opts.Deadline)
+		defer cancel()
+	}
+	emit := func(e Event) {
+		if opts.OnEvent != nil {
+			opts.OnEvent(e)
+		}
+	}
+
+	delay := opts.MinInterval
+	if delay <= 0 {
+		delay = 15 * time.Second
+	}
+	maxDelay := opts.MaxInterval
+	if maxDelay <= 0 {
+		maxDelay = 5 * time.Minute
+	}
+
+	var findErr error
+	for attempt := 1; ; attempt++ {
+		// Availability errors may be transient (and partial failures are fine as long as some cluster
+		// has capacity), so we report them and keep polling until the deadline.
+		candidates, err := find(ctx)
+		findErr = err
+		emit(Event{Type: Polled, Attempt: attempt, Candidates: len(candidates), Err: err})
+
+		for _, c := range candidates {
+			emit(Event{Type: Attempting, Attempt: attempt, Candidate: &c})
+			created, err := create(ctx, c)
+			if err == nil {
+				emit(Event{Type: Created, Attempt: attempt, Candidate: &c})
+				return created, nil
+			}
+			if !IsCapacityError(err) {
+				return nil, err
+			}
+			emit(Event{Type: Conflict, Attempt: attempt, Candidate: &c, Err: err})
+		}
+
+		emit(Event{Type: Waiting, Attempt: attempt, Delay: delay})
+		select {
+		case <-ctx.Done():
+			if findErr != nil {
+				return nil, fmt.Errorf("no capacity found after %d attempt(s): %w (last availability error: %v)", attempt, ctx.Err(), findErr)
+			}
+			return nil, fmt.Errorf("no capacity found after %d attempt(s): %w", attempt, ctx.Err())
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxDelay)
+	}
+}
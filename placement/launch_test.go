@@ -0,0 +1,229 @@
+package placement_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/denvrtest"
+	"github.com/denvrdata/go-denvr/placement"
+	"github.com/denvrdata/go-denvr/response"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLaunchServerWhenAvailable(t *testing.T) {
+	var polls atomic.Int32
+	var available atomic.Bool
+	server := denvrtest.NewServer(t)
+	server.Reply("/api/v1/servers/virtual/GetConfigurations", http.StatusOK, `{"items": [{"name": "A100_40GB_PCIe_1x", "type": "nvidia.com/A100PCIE40GB", "gpus": 1, "price": 2.05, "clusters": ["Hou1", "Msc1"]}]}`)
+	server.HandleFunc(
+		"/api/v1/servers/virtual/GetAvailability",
+		func(resp http.ResponseWriter, req *http.Request) {
+			cluster := req.URL.Query().Get("cluster")
+			if cluster == "Hou1" && polls.Add(1) >= 3 {
+				available.Store(true)
+			}
+			json.NewEncoder(resp).Encode(
+				map[string]any{
+					"items": []map[string]any{
+						{"configuration": "A100_40GB_PCIe_1x", "cluster": cluster, "rpool": "on-demand", "available": available.Load()},
+					},
+				},
+			)
+		},
+	)
+	server.HandleFunc(
+		"/api/v1/servers/virtual/CreateServer",
+		func(resp http.ResponseWriter, req *http.Request) {
+			var input virtual.CreateVirtualServerInput
+			json.NewDecoder(req.Body).Decode(&input)
+			// Someone else grabbed the last Hou1 server
+			if input.Cluster == "Hou1" {
+				resp.WriteHeader(http.StatusConflict)
+				resp.Write([]byte(`{"success": false, "error": {"code": 409, "message": "Insufficient capacity"}}`))
+				return
+			}
+			json.NewEncoder(resp).Encode(map[string]any{"id": *input.Name, "cluster": input.Cluster, "status": "PENDING"})
+		},
+	)
+
+	vc := server.Virtual()
+	input := virtual.CreateVirtualServerInput{Vpc: "denvr", Name: ptr("trainer")}
+
+	t.Run(
+		"Launch",
+		func(t *testing.T) {
+			events := []placement.EventType{}
+			vm, err := placement.LaunchServerWhenAvailable(
+				context.TODO(),
+				vc,
+				input,
+				placement.LaunchOptions{
+					Constraints: placement.Constraints{GpuType: "nvidia.com/A100PCIE40GB", Workers: 1},
+					MinInterval: time.Millisecond,
+					MaxInterval: 2 * time.Millisecond,
+					Deadline:    time.Now().Add(5 * time.Second),
+					OnEvent:     func(e placement.Event) { events = append(events, e.Type) },
+				},
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, "Msc1", *vm.Cluster)
+			assert.Equal(
+				t,
+				[]placement.EventType{
+					placement.Polled, placement.Waiting,
+					placement.Polled, placement.Waiting,
+					placement.Polled, placement.Attempting, placement.Conflict, placement.Attempting, placement.Created,
+				},
+				events,
+			)
+		},
+	)
+
+	t.Run(
+		"Deadline",
+		func(t *testing.T) {
+			_, err := placement.LaunchServerWhenAvailable(
+				context.TODO(),
+				vc,
+				input,
+				placement.LaunchOptions{
+					Constraints: placement.Constraints{GpuType: "nvidia.com/H100SXM80GB"},
+					MinInterval: time.Millisecond,
+					Deadline:    time.Now().Add(20 * time.Millisecond),
+				},
+			)
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+			assert.ErrorContains(t, err, "no capacity found")
+		},
+	)
+}
+
+func TestLaunchRetriesFailedPolls(t *testing.T) {
+	// Only the first poll fails
+	var polls, failures atomic.Int32
+	failures.Store(1)
+	server := denvrtest.NewServer(t)
+	server.Reply("/api/v1/servers/virtual/GetConfigurations", http.StatusOK, `{"items": [{"name": "A100_40GB_PCIe_1x", "type": "nvidia.com/A100PCIE40GB", "gpus": 1, "price": 2.05, "clusters": ["Msc1"]}]}`)
+	server.HandleFunc(
+		"/api/v1/servers/virtual/GetAvailability",
+		func(resp http.ResponseWriter, req *http.Request) {
+			if polls.Add(1) <= failures.Load() {
+				resp.WriteHeader(http.StatusServiceUnavailable)
+				resp.Write([]byte(`{"success": false, "error": {"code": 503, "message": "try again later"}}`))
+				return
+			}
+			resp.Write([]byte(`{"items": [{"configuration": "A100_40GB_PCIe_1x", "cluster": "Msc1", "rpool": "on-demand", "available": true}]}`))
+		},
+	)
+	server.Reply("/api/v1/servers/virtual/CreateServer", http.StatusOK, `{"id": "trainer", "cluster": "Msc1", "status": "PENDING"}`)
+
+	var events []placement.Event
+	vm, err := placement.LaunchServerWhenAvailable(
+		context.TODO(),
+		server.Virtual(),
+		virtual.CreateVirtualServerInput{Vpc: "denvr", Name: ptr("trainer")},
+		placement.LaunchOptions{
+			Constraints: placement.Constraints{GpuType: "nvidia.com/A100PCIE40GB"},
+			MinInterval: time.Millisecond,
+			Deadline:    time.Now().Add(5 * time.Second),
+			OnEvent:     func(e placement.Event) { events = append(events, e) },
+		},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "Msc1", vm.GetCluster())
+
+	// The failed poll is reported and retried after the usual delay
+	types := []placement.EventType{}
+	for _, e := range events {
+		types = append(types, e.Type)
+	}
+	assert.Equal(
+		t,
+		[]placement.EventType{placement.Polled, placement.Waiting, placement.Polled, placement.Attempting, placement.Created},
+		types,
+	)
+	assert.EqualError(t, events[0].Err, "Msc1: 503 Service Unavailable - try again later")
+	assert.NoError(t, events[2].Err)
+
+	// Polls which keep failing are retried until the deadline
+	failures.Store(math.MaxInt32)
+	_, err = placement.LaunchServerWhenAvailable(
+		context.TODO(),
+		server.Virtual(),
+		virtual.CreateVirtualServerInput{Vpc: "denvr", Name: ptr("trainer")},
+		placement.LaunchOptions{
+			Constraints: placement.Constraints{GpuType: "nvidia.com/A100PCIE40GB"},
+			MinInterval: time.Millisecond,
+			Deadline:    time.Now().Add(20 * time.Millisecond),
+		},
+	)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestLaunchConflict(t *testing.T) {
+	var creates []string
+	server := denvrtest.NewServer(t)
+	server.Reply("/api/v1/servers/virtual/GetConfigurations", http.StatusOK, `{"items": [{"name": "A100_40GB_PCIe_1x", "type": "nvidia.com/A100PCIE40GB", "gpus": 1, "price": 2.05, "clusters": ["Hou1", "Msc1"]}]}`)
+	server.HandleFunc(
+		"/api/v1/servers/virtual/GetAvailability",
+		func(resp http.ResponseWriter, req *http.Request) {
+			json.NewEncoder(resp).Encode(
+				map[string]any{
+					"items": []map[string]any{
+						{"configuration": "A100_40GB_PCIe_1x", "cluster": req.URL.Query().Get("cluster"), "rpool": "on-demand", "available": true},
+					},
+				},
+			)
+		},
+	)
+	server.HandleFunc(
+		"/api/v1/servers/virtual/CreateServer",
+		func(resp http.ResponseWriter, req *http.Request) {
+			var input virtual.CreateVirtualServerInput
+			json.NewDecoder(req.Body).Decode(&input)
+			creates = append(creates, input.Cluster)
+			resp.WriteHeader(http.StatusConflict)
+			resp.Write([]byte(`{"success": false, "error": {"code": 409, "message": "A server named trainer already exists"}}`))
+		},
+	)
+	// The name is taken everywhere, so trying the next cluster could only create a duplicate
+	_, err := placement.LaunchServerWhenAvailable(
+		context.TODO(),
+		server.Virtual(),
+		virtual.CreateVirtualServerInput{Vpc: "denvr", Name: ptr("trainer")},
+		placement.LaunchOptions{
+			Constraints: placement.Constraints{GpuType: "nvidia.com/A100PCIE40GB", Workers: 1},
+			MinInterval: time.Millisecond,
+			Deadline:    time.Now().Add(5 * time.Second),
+		},
+	)
+	assert.ErrorContains(t, err, "already exists")
+	assert.Len(t, creates, 1)
+}
+
+func TestIsCapacityError(t *testing.T) {
+	for _, tc := range []struct {
+		err      error
+		capacity bool
+	}{
+		{&response.StatusError{StatusCode: http.StatusConflict, Message: "Insufficient capacity"}, true},
+		{&response.StatusError{StatusCode: http.StatusBadRequest, Message: "Not enough GPUs available on the node"}, true},
+		{&response.StatusError{StatusCode: http.StatusConflict, Message: "A server named trainer already exists"}, false},
+		{&response.StatusError{StatusCode: http.StatusConflict}, false},
+		{&response.StatusError{StatusCode: http.StatusServiceUnavailable, Message: "Service unavailable"}, false},
+		{&response.StatusError{StatusCode: http.StatusServiceUnavailable, Message: "Insufficient capacity"}, false},
+		{&response.StatusError{StatusCode: http.StatusBadRequest, Message: "Image not available"}, false},
+		{errors.New("insufficient capacity"), false},
+	} {
+		assert.Equal(t, tc.capacity, placement.IsCapacityError(tc.err), tc.err.Error())
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
@@ -0,0 +1,275 @@
+// Package placement finds where a server or application can be launched by combining
+// configuration details with availability across clusters and resource pools.
+package placement
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/denvrdata/go-denvr/api/v1/models"
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/fanout"
+)
+
+// Constraints filter the candidate configurations. Zero values are ignored.
+type Constraints struct {
+	// GpuType is matched case-insensitively against the configuration GPU type (e.g., "nvidia.com/A100PCIE40GB")
+	GpuType   string
+	MinGpus   int32
+	MaxGpus   int32
+	MinMemory models.Quantity
+	MinVcpus  int32
+	// Clusters to search. If empty, all clusters are discovered from the configurations.
+	Clusters []string
+	// Rpools to search. Applications default to "on-demand" when empty.
+	Rpools   []string
+	MaxPrice float64
+	// Workers is the maximum number of concurrent availability requests (defaults to 4)
+	Workers int
+}
+
+// Candidate is an available configuration in a specific cluster and resource pool.
+type Candidate struct {
+	Cluster       string
+	Configuration string
+	Rpool         string
+	// SelectedNode is the first available node, only set for non-on-demand resource pools
+	SelectedNode string
+	Price        float64
+	// Count is the number of resources which can still be created with this configuration
+	Count   int32
+	GpuType string
+	Gpus    int32
+	Vcpus   int32
+	Memory  models.Quantity
+}
+
+// ServerInput returns a copy of input with the cluster, configuration, rpool and selected node filled in.
+func (c Candidate) ServerInput(input virtual.CreateVirtualServerInput) virtual.CreateVirtualServerInput {
+	input.Cluster = c.Cluster
+	input.Configuration = c.Configuration
+	if c.Rpool != "" {
+		input.Rpool = &c.Rpool
+	}
+	input.SelectedNode = nil
+	if c.SelectedNode != "" {
+		input.SelectedNode = &c.SelectedNode
+	}
+	return input
+}
+
+// CatalogRequest returns a copy of req with the cluster, hardware package, resource pool and selected node filled in.
+func (c Candidate) CatalogRequest(req applications.ApplicationsApiCreateRequest) applications.ApplicationsApiCreateRequest {
+	req.Cluster = c.Cluster
+	req.HardwarePackageName = c.Configuration
+	req.ResourcePool = &c.Rpool
+	req.SelectedNode = nil
+	if c.SelectedNode != "" {
+		req.SelectedNode = &c.SelectedNode
+	}
+	return req
+}
+
+// CustomRequest returns a copy of req with the cluster, hardware package, resource pool and selected node filled in.
+func (c Candidate) CustomRequest(req applications.ApplicationsApiCustomApiCreateRequest) applications.ApplicationsApiCustomApiCreateRequest {
+	req.Cluster = c.Cluster
+	req.HardwarePackageName = c.Configuration
+	req.ResourcePool = &c.Rpool
+	req.SelectedNode = nil
+	if c.SelectedNode != "" {
+		req.SelectedNode = &c.SelectedNode
+	}
+	return req
+}
+
+// spec describes the hardware of a configuration, independent of the service it came from.
+type spec struct {
+	GpuType string
+	Gpus    int32
+	Vcpus   int32
+	Memory  models.Quantity
+	Price   float64
+}
+
+func (c Constraints) matches(s spec) bool {
+	return (c.GpuType == "" || strings.EqualFold(c.GpuType, s.GpuType)) &&
+		(c.MinGpus == 0 || s.Gpus >= c.MinGpus) &&
+		(c.MaxGpus == 0 || s.Gpus <= c.MaxGpus) &&
+		(c.MinMemory == 0 || s.Memory >= c.MinMemory) &&
+		(c.MinVcpus == 0 || s.Vcpus >= c.MinVcpus)
+}
+
+// Servers returns the available virtual server configurations matching the constraints, cheapest first.
+// Candidates from clusters which succeeded are returned alongside an error describing any clusters which failed.
+func Servers(ctx context.Context, vc virtual.ClientInterface, c Constraints) ([]Candidate, error) {
+	rsp, err := vc.GetConfigurations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	specs := map[string]spec{}
+	for _, config := range rsp.GetItems() {
+		specs[config.GetName()] = spec{
+			GpuType: config.GetType(),
+			Gpus:    config.GetGpus(),
+			Vcpus:   config.GetVcpus(),
+			Memory:  config.GetMemory().Quantity,
+			Price:   config.GetPrice(),
+		}
+	}
+
+	clusters := c.Clusters
+	if len(clusters) == 0 {
+		if clusters, err = fanout.Clusters(ctx, vc, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	// An empty rpool list means we ask for every rpool available to the tenant.
+	rpools := []*string{nil}
+	if len(c.Rpools) > 0 {
+		rpools = nil
+		for _, rpool := range c.Rpools {
+			rpools = append(rpools, &rpool)
+		}
+	}
+
+	result := fanout.Map(
+		ctx,
+		clusters,
+		c.Workers,
+		func(ctx context.Context, cluster string) ([]Candidate, error) {
+			var candidates []Candidate
+			for _, rpool := range rpools {
+				rsp, err := vc.GetAvailability(ctx, &virtual.GetAvailabilityParams{Cluster: cluster, ResourcePool: rpool})
+				if err != nil {
+					return nil, err
+				}
+				for _, avail := range rsp.GetItems() {
+					s, ok := specs[avail.GetConfiguration()]
+					if !ok || !avail.GetAvailable() || !c.matches(s) {
+						continue
+					}
+					candidates = append(
+						candidates,
+						candidate(cluster, &avail, s),
+					)
+				}
+			}
+			return candidates, nil
+		},
+	)
+
+	return rank(result, c), result.Err()
+}
+
+// Applications returns the available application hardware packages matching the constraints, cheapest first.
+// Candidates from clusters which succeeded are returned alongside an error describing any clusters which failed.
+func Applications(ctx context.Context, ac applications.ClientInterface, c Constraints) ([]Candidate, error) {
+	rsp, err := ac.GetConfigurations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	specs := map[string]spec{}
+	for _, config := range rsp.GetItems() {
+		specs[config.GetName()] = spec{
+			GpuType: config.GetGpuType(),
+			Gpus:    config.GetGpuCount(),
+			Vcpus:   config.GetVcpusCount(),
+			Memory:  config.GetMemoryGb().Quantity,
+			Price:   config.GetPricePerHour(),
+		}
+	}
+
+	clusters := c.Clusters
+	if len(clusters) == 0 {
+		if clusters, err = fanout.Clusters(ctx, nil, ac); err != nil {
+			return nil, err
+		}
+	}
+
+	rpools := c.Rpools
+	if len(rpools) == 0 {
+		rpools = []string{"on-demand"}
+	}
+
+	result := fanout.Map(
+		ctx,
+		clusters,
+		c.Workers,
+		func(ctx context.Context, cluster string) ([]Candidate, error) {
+			var candidates []Candidate
+			for _, rpool := range rpools {
+				rsp, err := ac.GetAvailability(ctx, &applications.GetAvailabilityParams{Cluster: cluster, ResourcePool: &rpool})
+				if err != nil {
+					return nil, err
+				}
+				for _, avail := range rsp.GetItems() {
+					s, ok := specs[avail.GetConfiguration()]
+					if !ok || !avail.GetAvailable() || !c.matches(s) {
+						continue
+					}
+					if avail.Rpool == nil {
+						avail.Rpool = &rpool
+					}
+					candidates = append(
+						candidates,
+						candidate(cluster, &avail, s),
+					)
+				}
+			}
+			return candidates, nil
+		},
+	)
+
+	return rank(result, c), result.Err()
+}
+
+func candidate(cluster string, avail *models.Availability, s spec) Candidate {
+	c := Candidate{
+		Cluster:       cluster,
+		Configuration: avail.GetConfiguration(),
+		Rpool:         avail.GetRpool(),
+		Price:         s.Price,
+		Count:         avail.GetCount(),
+		GpuType:       s.GpuType,
+		Gpus:          s.Gpus,
+		Vcpus:         s.Vcpus,
+		Memory:        s.Memory,
+	}
+	// Availability pricing is rpool specific, so prefer it over the configuration list price.
+	if avail.Price != nil {
+		c.Price = *avail.Price
+	}
+	if names := avail.GetAvailableNodeNames(); len(names) > 0 {
+		c.SelectedNode = names[0]
+	}
+	return c
+}
+
+// rank filters candidates by price and sorts them by price, remaining capacity and then name.
+func rank(result fanout.Result[Candidate], c Constraints) []Candidate {
+	var candidates []Candidate
+	for _, item := range result.Items {
+		if c.MaxPrice == 0 || item.Item.Price <= c.MaxPrice {
+			candidates = append(candidates, item.Item)
+		}
+	}
+	slices.SortStableFunc(
+		candidates,
+		func(a, b Candidate) int {
+			return cmp.Or(
+				cmp.Compare(a.Price, b.Price),
+				cmp.Compare(b.Count, a.Count),
+				cmp.Compare(a.Cluster, b.Cluster),
+				cmp.Compare(a.Rpool, b.Rpool),
+				cmp.Compare(a.Configuration, b.Configuration),
+			)
+		},
+	)
+	return candidates
+}
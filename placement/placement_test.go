@@ -0,0 +1,144 @@
+package placement_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/denvrdata/go-denvr/api/v1/models"
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/denvrtest"
+	"github.com/denvrdata/go-denvr/placement"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlacement(t *testing.T) {
+	server := denvrtest.NewServer(t)
+	server.Reply(
+		"/api/v1/servers/virtual/GetConfigurations",
+		http.StatusOK,
+		`{
+			"items": [
+				{"name": "A100_40GB_PCIe_1x", "type": "nvidia.com/A100PCIE40GB", "gpus": 1, "vcpus": 14, "memory": 112, "price": 2.05, "clusters": ["Hou1", "Msc1"]},
+				{"name": "A100_40GB_PCIe_2x", "type": "nvidia.com/A100PCIE40GB", "gpus": 2, "vcpus": 28, "memory": 224, "price": 4.1, "clusters": ["Hou1", "Msc1"]},
+				{"name": "H100_80GB_SXM_8x", "type": "nvidia.com/H100SXM80GB", "gpus": 8, "vcpus": 198, "memory": 970, "price": 20.0, "clusters": ["Hou1"]}
+			]
+		}`,
+	)
+	server.HandleFunc(
+		"/api/v1/servers/virtual/GetAvailability",
+		func(resp http.ResponseWriter, req *http.Request) {
+			switch req.URL.Query().Get("cluster") {
+			case "Hou1":
+				resp.Write([]byte(`{
+					"items": [
+						{"configuration": "A100_40GB_PCIe_1x", "cluster": "Hou1", "rpool": "on-demand", "available": true, "count": 2, "price": 2.05},
+						{"configuration": "A100_40GB_PCIe_2x", "cluster": "Hou1", "rpool": "reserved-denvr", "available": true, "count": 1, "price": 3.5, "availableNodeNames": ["node-7", "node-8"]},
+						{"configuration": "H100_80GB_SXM_8x", "cluster": "Hou1", "rpool": "on-demand", "available": true, "count": 1, "price": 20.0}
+					]
+				}`))
+			case "Msc1":
+				resp.Write([]byte(`{
+					"items": [
+						{"configuration": "A100_40GB_PCIe_1x", "cluster": "Msc1", "rpool": "on-demand", "available": true, "count": 5, "price": 2.05},
+						{"configuration": "A100_40GB_PCIe_2x", "cluster": "Msc1", "rpool": "on-demand", "available": false, "count": 0, "price": 4.1}
+					]
+				}`))
+			}
+		},
+	)
+	server.Reply("/api/v1/servers/applications/GetConfigurations", http.StatusOK, `{"items": [{"name": "cpu-4vcpu", "gpuCount": 0, "vcpusCount": 4, "memoryGb": 16, "pricePerHour": 0.2, "clusters": ["Msc1"]}]}`)
+	server.HandleFunc(
+		"/api/v1/servers/applications/GetAvailability",
+		func(resp http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "on-demand", req.URL.Query().Get("resourcePool"))
+			resp.Write([]byte(`{"items": [{"configuration": "cpu-4vcpu", "cluster": "Msc1", "available": true, "count": 3}]}`))
+		},
+	)
+
+	vc := server.Virtual()
+	ac := server.Applications()
+
+	t.Run(
+		"Servers",
+		func(t *testing.T) {
+			candidates, err := placement.Servers(
+				context.TODO(),
+				vc,
+				placement.Constraints{GpuType: "nvidia.com/a100pcie40gb", MaxPrice: 4},
+			)
+			assert.NoError(t, err)
+
+			found := []string{}
+			for _, c := range candidates {
+				found = append(found, c.Cluster+"/"+c.Rpool+"/"+c.Configuration)
+			}
+			assert.Equal(
+				t,
+				[]string{
+					"Msc1/on-demand/A100_40GB_PCIe_1x",
+					"Hou1/on-demand/A100_40GB_PCIe_1x",
+					"Hou1/reserved-denvr/A100_40GB_PCIe_2x",
+				},
+				found,
+			)
+
+			input := candidates[2].ServerInput(virtual.CreateVirtualServerInput{Vpc: "denvr"})
+			assert.Equal(t, "Hou1", input.Cluster)
+			assert.Equal(t, "A100_40GB_PCIe_2x", input.Configuration)
+			assert.Equal(t, "reserved-denvr", *input.Rpool)
+			assert.Equal(t, "node-7", *input.SelectedNode)
+			assert.Equal(t, "denvr", input.Vpc)
+		},
+	)
+
+	t.Run(
+		"ServersMinGpus",
+		func(t *testing.T) {
+			candidates, err := placement.Servers(
+				context.TODO(),
+				vc,
+				placement.Constraints{MinGpus: 2, MinMemory: 500 * models.GB, Clusters: []string{"Hou1"}},
+			)
+			assert.NoError(t, err)
+			assert.Len(t, candidates, 1)
+			assert.Equal(t, "H100_80GB_SXM_8x", candidates[0].Configuration)
+			assert.Equal(t, int32(8), candidates[0].Gpus)
+			assert.Equal(t, 970*models.GB, candidates[0].Memory)
+		},
+	)
+
+	t.Run(
+		"Applications",
+		func(t *testing.T) {
+			candidates, err := placement.Applications(context.TODO(), ac, placement.Constraints{MinVcpus: 4})
+			assert.NoError(t, err)
+			assert.Len(t, candidates, 1)
+			assert.Equal(t, 0.2, candidates[0].Price)
+			assert.Equal(t, 16*models.GB, candidates[0].Memory)
+
+			req := candidates[0].CatalogRequest(applications.ApplicationsApiCreateRequest{Name: "notebook"})
+			assert.Equal(t, "Msc1", req.Cluster)
+			assert.Equal(t, "cpu-4vcpu", req.HardwarePackageName)
+			assert.Equal(t, "on-demand", *req.ResourcePool)
+			assert.Nil(t, req.SelectedNode)
+		},
+	)
+}
+
+func TestServerInput(t *testing.T) {
+	input := virtual.CreateVirtualServerInput{Vpc: "denvr", Name: ptr("trainer"), Rpool: ptr("reserved-denvr")}
+
+	c := placement.Candidate{Cluster: "Msc1", Configuration: "A100_40GB_PCIe_1x", Rpool: "on-demand"}
+	actual := c.ServerInput(input)
+	assert.Equal(t, "Msc1", actual.Cluster)
+	assert.Equal(t, "A100_40GB_PCIe_1x", actual.Configuration)
+	assert.Equal(t, "on-demand", *actual.Rpool)
+	assert.Nil(t, actual.SelectedNode)
+
+	// Candidates without a resource pool keep the one from the input
+	c.Rpool = ""
+	assert.Equal(t, "reserved-denvr", *c.ServerInput(input).Rpool)
+	assert.Nil(t, c.ServerInput(virtual.CreateVirtualServerInput{}).Rpool)
+}
@@ -0,0 +1,346 @@
+// Package reaper finds servers and applications which have been left running for too long
+// and stops or destroys them.
+//
+// Resources are flagged once they have been in a reapable status (ONLINE by default) for longer
+// than Rules.MaxAge. Flagged resources are only acted on after an additional Rules.GracePeriod,
+// which gives the notification hooks time to warn the owner.
+//
+// Names may also carry labels, separated by dashes, which override the rules:
+//
+//   - `keep` (Rules.KeepLabel) exempts the resource entirely (e.g., `train-llama-keep`)
+//   - `ttl<duration>` overrides the max age (e.g., `notebook-ttl72h`)
+package reaper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/denvrdata/go-denvr/api/v1/models"
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+)
+
+type Kind string
+
+const (
+	Server      Kind = "server"
+	Application Kind = "application"
+)
+
+type Action string
+
+const (
+	Stop    Action = "stop"
+	Destroy Action = "destroy"
+)
+
+// Rules select which resources are reaped. Empty lists match everything.
+type Rules struct {
+	// MaxAge is how long a resource may stay in a reapable status before it is flagged
+	MaxAge time.Duration
+	// GracePeriod is how long a flagged resource is left alone before it is reaped
+	GracePeriod time.Duration
+	// Statuses which are considered reapable (defaults to ONLINE)
+	Statuses []string
+	// Owners restricts reaping to resources created by these users (case-insensitive)
+	Owners []string
+	// Names are glob patterns (see path.Match) the resource name must match
+	Names []string
+	// Allowlist are glob patterns for names, or cluster/name pairs, which are never reaped
+	Allowlist []string
+	// KeepLabel is the name label which exempts a resource (defaults to "keep")
+	KeepLabel string
+}
+
+// Candidate is a resource which matched the rules.
+type Candidate struct {
+	Kind      Kind
+	Cluster   string
+	Name      string
+	Namespace string
+	Owner     string
+	Status    string
+	// Since is when the resource entered its current status
+	Since time.Time
+	Age   time.Duration
+	// ReapAt is when the grace period ends
+	ReapAt time.Time
+	// Due reports whether the grace period has ended
+	Due bool
+}
+
+func (c Candidate) String() string {
+	return fmt.Sprintf("%s %s/%s", c.Kind, c.Cluster, c.Name)
+}
+
+type EventType string
+
+const (
+	// Flagged is emitted for candidates which are still within their grace period.
+	Flagged EventType = "flagged"
+	// Reaping is emitted before a due candidate is stopped or destroyed.
+	Reaping EventType = "reaping"
+	// Reaped is emitted once the action succeeds (or would have, during a dry run).
+	Reaped EventType = "reaped"
+	// Failed is emitted when the action fails.
+	Failed EventType = "failed"
+	// Skipped is emitted by Scan for matching resources which can't be evaluated (e.g., missing timestamps).
+	Skipped EventType = "skipped"
+)
+
+// Event is passed to the notification hook.
+type Event struct {
+	Type      EventType
+	Action    Action
+	Candidate Candidate
+	DryRun    bool
+	Err       error
+}
+
+// Reaper scans the provided clients for candidates. Either client may be nil to skip that service.
+type Reaper struct {
+	Servers      virtual.ClientInterface
+	Applications applications.ClientInterface
+	Rules        Rules
+	// Action taken on due candidates (defaults to Stop)
+	Action Action
+	// DryRun reports what would be reaped without making any changes
+	DryRun bool
+	// OnEvent is called synchronously for every flagged and reaped candidate if set
+	OnEvent func(Event)
+	// Now defaults to time.Now, useful for testing
+	Now func() time.Time
+}
+
+// Scan returns every resource matching the rules, ordered by kind, cluster and name.
+// Resources whose age can't be determined are skipped and reported to OnEvent, rather than failing the whole scan.
+func (r *Reaper) Scan(ctx context.Context) ([]Candidate, error) {
+	now := time.Now()
+	if r.Now != nil {
+		now = r.Now()
+	}
+
+	var candidates []Candidate
+	if r.Servers != nil {
+		rsp, err := r.Servers.GetServers(ctx, &virtual.GetServersParams{})
+		if err != nil {
+			return nil, err
+		}
+		for _, vm := range rsp.GetItems() {
+			c := Candidate{
+				Kind:      Server,
+				Cluster:   vm.GetCluster(),
+				Name:      vm.GetId(),
+				Namespace: vm.GetNamespace(),
+				Owner:     vm.GetUsername(),
+				Status:    vm.GetStatus(),
+			}
+			if !r.matches(c) {
+				continue
+			}
+			// Unlike the application instance details, servers don't have a creation time to fall back to
+			since, err := timestamp(vm.GetLastUpdated())
+			if err != nil {
+				r.skip(c, err)
+				continue
+			}
+			if c, ok := r.evaluate(c, since, now); ok {
+				candidates = append(candidates, c)
+			}
+		}
+	}
+
+	if r.Applications != nil {
+		rsp, err := r.Applications.GetApplications(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, app := range rsp.GetItems() {
+			c := Candidate{
+				Kind:    Application,
+				Cluster: app.GetCluster(),
+				Name:    app.GetId(),
+				Owner:   app.GetCreatedBy(),
+				Status:  app.GetStatus(),
+			}
+			if !r.matches(c) {
+				continue
+			}
+			// The overview doesn't include any timestamps, so we only fetch details for matching applications.
+			details, err := r.Applications.GetApplicationDetails(
+				ctx,
+				&applications.GetApplicationDetailsParams{Id: c.Name, Cluster: c.Cluster},
+			)
+			if err != nil {
+				r.skip(c, err)
+				continue
+			}
+			instance := details.GetInstanceDetails()
+			since, err := timestamp(instance.GetLastUpdated(), instance.GetCreationTime())
+			if err != nil {
+				r.skip(c, err)
+				continue
+			}
+			if c, ok := r.evaluate(c, since, now); ok {
+				candidates = append(candidates, c)
+			}
+		}
+	}
+
+	return candidates, nil
+}
+
+// Reap scans for candidates, notifies about those still in their grace period and
+// stops or destroys those which are due. Failures don't stop the remaining candidates from being reaped.
+func (r *Reaper) Reap(ctx context.Context) ([]Candidate, error) {
+	candidates, err := r.Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	action := r.Action
+	if action == "" {
+		action = Stop
+	}
+
+	var reaped []Candidate
+	var errs []error
+	for _, c := range candidates {
+		if !c.Due {
+			r.emit(Event{Type: Flagged, Action: action, Candidate: c, DryRun: r.DryRun})
+			continue
+		}
+
+		r.emit(Event{Type: Reaping, Action: action, Candidate: c, DryRun: r.DryRun})
+		if !r.DryRun {
+			if err := r.apply(ctx, action, c); err != nil {
+				err = fmt.Errorf("%s %s: %w", action, c, err)
+				r.emit(Event{Type: Failed, Action: action, Candidate: c, Err: err})
+				errs = append(errs, err)
+				continue
+			}
+		}
+		r.emit(Event{Type: Reaped, Action: action, Candidate: c, DryRun: r.DryRun})
+		reaped = append(reaped, c)
+	}
+	return reaped, errors.Join(errs...)
+}
+
+func (r *Reaper) apply(ctx context.Context, action Action, c Candidate) error {
+	var err error
+	switch {
+	case c.Kind == Server && action == Stop:
+		_, err = r.Servers.StopServer(ctx, virtual.ServerCommandInput{Cluster: c.Cluster, Id: c.Name, Namespace: c.Namespace})
+	case c.Kind == Server && action == Destroy:
+		_, err = r.Servers.DestroyServer(
+			ctx,
+			&virtual.DestroyServerParams{Cluster: c.Cluster, Id: c.Name, Namespace: c.Namespace},
+		)
+	case c.Kind == Application && action == Stop:
+		_, err = r.Applications.StopApplication(ctx, applications.ApplicationsApiCommandRequest{Cluster: c.Cluster, Id: c.Name})
+	case c.Kind == Application && action == Destroy:
+		_, err = r.Applications.DestroyApplication(
+			ctx,
+			&applications.DestroyApplicationParams{Cluster: c.Cluster, Id: c.Name},
+		)
+	default:
+		err = fmt.Errorf("unknown action %q", action)
+	}
+	return err
+}
+
+// skip reports a matching resource which couldn't be evaluated.
+func (r *Reaper) skip(c Candidate, err error) {
+	r.emit(Event{Type: Skipped, Candidate: c, DryRun: r.DryRun, Err: fmt.Errorf("%s: %w", c, err)})
+}
+
+// timestamp returns the first valid timestamp, in order of preference.
+func timestamp(times ...models.Time) (time.Time, error) {
+	var errs []error
+	for _, t := range times {
+		if t.Err() != nil {
+			errs = append(errs, t.Err())
+		} else if !t.IsZero() {
+			return t.Time, nil
+		}
+	}
+	if len(errs) == 0 {
+		return time.Time{}, errors.New("missing timestamp")
+	}
+	return time.Time{}, errors.Join(errs...)
+}
+
+func (r *Reaper) emit(e Event) {
+	if r.OnEvent != nil {
+		r.OnEvent(e)
+	}
+}
+
+// matches applies the status, owner, naming and allowlist rules.
+func (r *Reaper) matches(c Candidate) bool {
+	statuses := r.Rules.Statuses
+	if len(statuses) == 0 {
+		statuses = []string{"ONLINE"}
+	}
+	if !slices.Contains(statuses, c.Status) {
+		return false
+	}
+	if len(r.Rules.Owners) > 0 && !slices.ContainsFunc(
+		r.Rules.Owners,
+		func(owner string) bool { return strings.EqualFold(owner, c.Owner) },
+	) {
+		return false
+	}
+	if len(r.Rules.Names) > 0 && !matchAny(r.Rules.Names, c.Name) {
+		return false
+	}
+	if matchAny(r.Rules.Allowlist, c.Name) || matchAny(r.Rules.Allowlist, c.Cluster+"/"+c.Name) {
+		return false
+	}
+
+	keep := r.Rules.KeepLabel
+	if keep == "" {
+		keep = "keep"
+	}
+	return !slices.Contains(labels(c.Name), keep)
+}
+
+// evaluate applies the age rules, returning false if the resource isn't old enough to be flagged.
+func (r *Reaper) evaluate(c Candidate, since, now time.Time) (Candidate, bool) {
+	maxAge := r.Rules.MaxAge
+	for _, label := range labels(c.Name) {
+		if ttl, ok := strings.CutPrefix(label, "ttl"); ok {
+			if d, err := time.ParseDuration(ttl); err == nil {
+				maxAge = d
+			}
+		}
+	}
+
+	c.Since = since
+	c.Age = now.Sub(since)
+	if c.Age <= maxAge {
+		return c, false
+	}
+	c.ReapAt = since.Add(maxAge + r.Rules.GracePeriod)
+	c.Due = !now.Before(c.ReapAt)
+	return c, true
+}
+
+func labels(name string) []string {
+	return strings.Split(strings.ToLower(name), "-")
+}
+
+func matchAny(patterns []string, name string) bool {
+	return slices.ContainsFunc(
+		patterns,
+		func(pattern string) bool {
+			ok, _ := path.Match(pattern, name)
+			return ok
+		},
+	)
+}
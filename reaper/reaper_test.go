@@ -0,0 +1,173 @@
+package reaper_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/denvrdata/go-denvr/denvrtest"
+	"github.com/denvrdata/go-denvr/reaper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReaper(t *testing.T) {
+	var mu sync.Mutex
+	var calls []string
+	record := func(req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls = append(calls, req.Method+" "+req.URL.Path+" "+req.URL.RawQuery)
+	}
+
+	server := denvrtest.NewServer(t)
+	server.Reply(
+		"/api/v1/servers/virtual/GetServers",
+		http.StatusOK,
+		`{
+			"items": [
+				{"id": "old", "cluster": "Msc1", "namespace": "denvr", "username": "alice", "status": "ONLINE", "lastUpdated": "2024-11-01T00:00:00Z"},
+				{"id": "grace", "cluster": "Msc1", "namespace": "denvr", "username": "alice", "status": "ONLINE", "lastUpdated": "2024-11-01T23:30:00Z"},
+				{"id": "new", "cluster": "Msc1", "namespace": "denvr", "username": "alice", "status": "ONLINE", "lastUpdated": "2024-11-02T11:00:00Z"},
+				{"id": "stopped", "cluster": "Msc1", "namespace": "denvr", "username": "alice", "status": "OFFLINE", "lastUpdated": "2024-10-01T00:00:00Z"},
+				{"id": "train-keep", "cluster": "Msc1", "namespace": "denvr", "username": "alice", "status": "ONLINE", "lastUpdated": "2024-10-01T00:00:00Z"},
+				{"id": "long-ttl72h", "cluster": "Msc1", "namespace": "denvr", "username": "alice", "status": "ONLINE", "lastUpdated": "2024-11-01T00:00:00Z"},
+				{"id": "prod-api", "cluster": "Msc1", "namespace": "denvr", "username": "alice", "status": "ONLINE", "lastUpdated": "2024-10-01T00:00:00Z"},
+				{"id": "other", "cluster": "Msc1", "namespace": "denvr", "username": "bob", "status": "ONLINE", "lastUpdated": "2024-10-01T00:00:00Z"},
+				{"id": "unknown", "cluster": "Msc1", "namespace": "denvr", "username": "alice", "status": "ONLINE"},
+				{"id": "offset", "cluster": "Msc1", "namespace": "denvr", "username": "alice", "status": "ONLINE", "lastUpdated": "2024-10-01T00:00:00+0000"}
+			]
+		}`,
+	)
+	server.HandleFunc(
+		"/api/v1/servers/virtual/StopServer",
+		func(resp http.ResponseWriter, req *http.Request) {
+			record(req)
+			resp.Write([]byte(`{"id": "old", "cluster": "Msc1"}`))
+		},
+	)
+	server.Reply(
+		"/api/v1/servers/applications/GetApplications",
+		http.StatusOK,
+		`{
+			"items": [
+				{"id": "notebook", "cluster": "Hou1", "createdBy": "Alice", "status": "ONLINE"},
+				{"id": "broken", "cluster": "Hou1", "createdBy": "Alice", "status": "ONLINE"}
+			]
+		}`,
+	)
+	server.HandleFunc(
+		"/api/v1/servers/applications/GetApplicationDetails",
+		func(resp http.ResponseWriter, req *http.Request) {
+			if req.URL.Query().Get("Id") == "broken" {
+				resp.WriteHeader(http.StatusInternalServerError)
+				resp.Write([]byte(`{"error": {"message": "boom"}}`))
+				return
+			}
+			resp.Write([]byte(`{"instanceDetails": {"id": "notebook", "cluster": "Hou1", "creationTime": "2024-10-30T12:00:00.123456"}}`))
+		},
+	)
+	server.HandleFunc(
+		"/api/v1/servers/applications/DestroyApplication",
+		func(resp http.ResponseWriter, req *http.Request) {
+			record(req)
+			resp.Write([]byte(`{"id": "notebook", "cluster": "Hou1"}`))
+		},
+	)
+	now := time.Date(2024, 11, 2, 12, 0, 0, 0, time.UTC)
+	newReaper := func() *reaper.Reaper {
+		return &reaper.Reaper{
+			Servers:      server.Virtual(),
+			Applications: server.Applications(),
+			Rules: reaper.Rules{
+				MaxAge:      12 * time.Hour,
+				GracePeriod: time.Hour,
+				Owners:      []string{"alice"},
+				Allowlist:   []string{"Msc1/prod-*"},
+			},
+			Now: func() time.Time { return now },
+		}
+	}
+
+	t.Run(
+		"Scan",
+		func(t *testing.T) {
+			r := newReaper()
+			var skipped []string
+			r.OnEvent = func(e reaper.Event) {
+				assert.Equal(t, reaper.Skipped, e.Type)
+				skipped = append(skipped, e.Err.Error())
+			}
+			candidates, err := r.Scan(context.TODO())
+			assert.NoError(t, err)
+
+			// Resources without a valid timestamp are skipped rather than failing the scan
+			assert.Equal(
+				t,
+				[]string{
+					"server Msc1/unknown: missing timestamp",
+					`server Msc1/offset: unsupported timestamp "2024-10-01T00:00:00+0000"`,
+					"application Hou1/broken: 500 Internal Server Error - boom",
+				},
+				skipped,
+			)
+
+			var names []string
+			for _, c := range candidates {
+				names = append(names, c.String())
+			}
+			assert.Equal(t, []string{"server Msc1/old", "server Msc1/grace", "application Hou1/notebook"}, names)
+			assert.True(t, candidates[0].Due)
+			assert.Equal(t, 36*time.Hour, candidates[0].Age)
+			assert.False(t, candidates[1].Due)
+			assert.Equal(t, time.Date(2024, 11, 2, 12, 30, 0, 0, time.UTC), candidates[1].ReapAt)
+			assert.True(t, candidates[2].Due)
+		},
+	)
+
+	t.Run(
+		"DryRun",
+		func(t *testing.T) {
+			r := newReaper()
+			r.DryRun = true
+			var events []string
+			r.OnEvent = func(e reaper.Event) {
+				assert.True(t, e.DryRun)
+				events = append(events, string(e.Type)+" "+e.Candidate.Name)
+			}
+			reaped, err := r.Reap(context.TODO())
+			assert.NoError(t, err)
+			assert.Len(t, reaped, 2)
+			assert.Equal(
+				t,
+				[]string{
+					"skipped unknown", "skipped offset", "skipped broken",
+					"reaping old", "reaped old", "flagged grace", "reaping notebook", "reaped notebook",
+				},
+				events,
+			)
+			assert.Empty(t, calls)
+		},
+	)
+
+	t.Run(
+		"Apply",
+		func(t *testing.T) {
+			r := newReaper()
+			r.Action = reaper.Destroy
+			r.Servers = nil
+			reaped, err := r.Reap(context.TODO())
+			assert.NoError(t, err)
+			assert.Len(t, reaped, 1)
+			assert.Equal(t, []string{"DELETE /api/v1/servers/applications/DestroyApplication Cluster=Hou1&Id=notebook"}, calls)
+
+			calls = nil
+			r = newReaper()
+			r.Applications = nil
+			_, err = r.Reap(context.TODO())
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"POST /api/v1/servers/virtual/StopServer "}, calls)
+		},
+	)
+}
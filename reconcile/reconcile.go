@@ -0,0 +1,488 @@
+// Package reconcile provides declarative plan/apply management of virtual servers and applications.
+//
+// A Desired state is diffed against the output of GetServers and GetApplications to produce a Plan
+// of creates, starts, stops and destroys, which can then be applied (or dry-run) with bounded concurrency.
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/waiter"
+)
+
+// State is the desired lifecycle state of a resource.
+type State string
+
+const (
+	Running State = "running"
+	Stopped State = "stopped"
+	Absent  State = "absent"
+)
+
+// Server is the desired state of a virtual server. The Input.Name is required as it identifies the server.
+type Server struct {
+	Input virtual.CreateVirtualServerInput
+	// State defaults to Running
+	State State
+}
+
+// Application is the desired state of an application. Exactly one of Catalog or Custom should be set.
+type Application struct {
+	Catalog *applications.ApplicationsApiCreateRequest
+	Custom  *applications.ApplicationsApiCustomApiCreateRequest
+	// State defaults to Running
+	State State
+}
+
+// Desired is the complete set of resources we want to exist.
+type Desired struct {
+	Servers      []Server
+	Applications []Application
+	// Prune destroys existing resources in the Clusters we manage which aren't listed above.
+	Prune bool
+}
+
+type Kind string
+
+const (
+	ServerKind      Kind = "server"
+	ApplicationKind Kind = "application"
+)
+
+type Op string
+
+const (
+	Create  Op = "create"
+	Start   Op = "start"
+	Stop    Op = "stop"
+	Destroy Op = "destroy"
+)
+
+// Action is a single step of a Plan.
+type Action struct {
+	Op        Op
+	Kind      Kind
+	Cluster   string
+	Name      string
+	Namespace string
+
+	server *Server
+	app    *Application
+	// await is the status the resource must reach before the action is applied (e.g., OFFLINE before restarting
+	// a STOPPING server)
+	await string
+}
+
+func (a Action) String() string {
+	return fmt.Sprintf("%s %s %s/%s", a.Op, a.Kind, a.Cluster, a.Name)
+}
+
+func (a Action) key() string {
+	return fmt.Sprintf("%s/%s/%s", a.Kind, a.Cluster, a.Name)
+}
+
+// Plan is an ordered list of actions. Actions on the same resource are applied in order.
+type Plan struct {
+	Actions []Action
+}
+
+func (p Plan) Empty() bool {
+	return len(p.Actions) == 0
+}
+
+// Result records the outcome of applying a single Action.
+type Result struct {
+	Action Action
+	Err    error
+}
+
+// Reconciler diffs and applies desired state using the provided clients.
+// Either client may be nil if the corresponding resources aren't being managed.
+type Reconciler struct {
+	Servers      virtual.ClientInterface
+	Applications applications.ClientInterface
+	// Concurrency is the maximum number of resources changed at once (defaults to 4)
+	Concurrency int
+	// Wait blocks each action until the resource reaches its expected status.
+	// Actions followed by another on the same resource (e.g., create then stop) always wait.
+	Wait bool
+	// DryRun reports the actions which would be taken without calling the API
+	DryRun bool
+	// WaitOptions controls polling while waiting
+	WaitOptions waiter.Options
+}
+
+// Plan compares the desired state against the existing servers and applications.
+func (r *Reconciler) Plan(ctx context.Context, desired Desired) (Plan, error) {
+	var plan Plan
+
+	if len(desired.Servers) > 0 || (desired.Prune && r.Servers != nil) {
+		if r.Servers == nil {
+			return plan, errors.New("desired state includes servers, but no virtual client was provided")
+		}
+		actions, err := r.planServers(ctx, desired)
+		if err != nil {
+			return plan, err
+		}
+		plan.Actions = append(plan.Actions, actions...)
+	}
+
+	if len(desired.Applications) > 0 || (desired.Prune && r.Applications != nil) {
+		if r.Applications == nil {
+			return plan, errors.New("desired state includes applications, but no applications client was provided")
+		}
+		actions, err := r.planApplications(ctx, desired)
+		if err != nil {
+			return plan, err
+		}
+		plan.Actions = append(plan.Actions, actions...)
+	}
+
+	return plan, nil
+}
+
+func (r *Reconciler) planServers(ctx context.Context, desired Desired) ([]Action, error) {
+	rsp, err := r.Servers.GetServers(ctx, &virtual.GetServersParams{})
+	if err != nil {
+		return nil, err
+	}
+
+	existing := map[string]virtual.VirtualServerDetailsItem{}
+	for _, item := range rsp.GetItems() {
+		existing[item.GetCluster()+"/"+item.GetId()] = item
+	}
+
+	var actions []Action
+	wanted := map[string]bool{}
+	clusters := map[string]bool{}
+	for i := range desired.Servers {
+		server := &desired.Servers[i]
+		name := server.Input.GetName()
+		if name == "" {
+			return nil, fmt.Errorf("desired server %d in cluster %s must have a name", i, server.Input.Cluster)
+		}
+		key := server.Input.Cluster + "/" + name
+		if wanted[key] {
+			return nil, fmt.Errorf("desired server %s is listed more than once", key)
+		}
+		wanted[key] = true
+		clusters[server.Input.Cluster] = true
+
+		action := Action{
+			Kind:      ServerKind,
+			Cluster:   server.Input.Cluster,
+			Name:      name,
+			Namespace: server.Input.Vpc,
+			server:    server,
+		}
+		current, exists := existing[key]
+		if exists && action.Namespace == "" {
+			action.Namespace = current.GetNamespace()
+		}
+		actions = append(actions, transitions(action, state(server.State), exists, current.GetStatus())...)
+	}
+
+	if desired.Prune {
+		for _, key := range slices.Sorted(maps.Keys(existing)) {
+			current := existing[key]
+			if !wanted[key] && clusters[current.GetCluster()] {
+				actions = append(
+					actions,
+					Action{
+						Op:        Destroy,
+						Kind:      ServerKind,
+						Cluster:   current.GetCluster(),
+						Name:      current.GetId(),
+						Namespace: current.GetNamespace(),
+					},
+				)
+			}
+		}
+	}
+
+	return actions, nil
+}
+
+func (r *Reconciler) planApplications(ctx context.Context, desired Desired) ([]Action, error) {
+	rsp, err := r.Applications.GetApplications(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := map[string]applications.ApplicationsApiOverview{}
+	for _, item := range rsp.GetItems() {
+		existing[item.GetCluster()+"/"+item.GetId()] = item
+	}
+
+	var actions []Action
+	wanted := map[string]bool{}
+	clusters := map[string]bool{}
+	for i := range desired.Applications {
+		app := &desired.Applications[i]
+		var cluster, name string
+		switch {
+		case app.Catalog != nil && app.Custom == nil:
+			cluster, name = app.Catalog.Cluster, app.Catalog.Name
+		case app.Custom != nil && app.Catalog == nil:
+			cluster, name = app.Custom.Cluster, app.Custom.Name
+		default:
+			return nil, fmt.Errorf("desired application %d must set exactly one of Catalog or Custom", i)
+		}
+		key := cluster + "/" + name
+		if wanted[key] {
+			return nil, fmt.Errorf("desired application %s is listed more than once", key)
+		}
+		wanted[key] = true
+		clusters[cluster] = true
+
+		current, exists := existing[key]
+		action := Action{Kind: ApplicationKind, Cluster: cluster, Name: name, app: app}
+		actions = append(actions, transitions(action, state(app.State), exists, current.GetStatus())...)
+	}
+
+	if desired.Prune {
+		for _, key := range slices.Sorted(maps.Keys(existing)) {
+			current := existing[key]
+			if !wanted[key] && clusters[current.GetCluster()] {
+				actions = append(
+					actions,
+					Action{Op: Destroy, Kind: ApplicationKind, Cluster: current.GetCluster(), Name: current.GetId()},
+				)
+			}
+		}
+	}
+
+	return actions, nil
+}
+
+// transitions returns the actions needed to move a resource from its current status to the desired state.
+// Resources which are already STARTING or STOPPING towards the desired state are left to converge,
+// while those heading the other way are acted on once they get there.
+func transitions(action Action, desired State, exists bool, status string) []Action {
+	with := func(op Op, await string) Action {
+		a := action
+		a.Op = op
+		a.await = await
+		return a
+	}
+
+	switch desired {
+	case Absent:
+		if exists {
+			return []Action{with(Destroy, "")}
+		}
+	case Stopped:
+		switch {
+		case !exists:
+			return []Action{with(Create, ""), with(Stop, "")}
+		case status == "STARTING":
+			return []Action{with(Stop, "ONLINE")}
+		case status != "OFFLINE" && status != "STOPPING":
+			return []Action{with(Stop, "")}
+		}
+	default:
+		switch {
+		case !exists:
+			return []Action{with(Create, "")}
+		case status == "STOPPING":
+			return []Action{with(Start, "OFFLINE")}
+		case status == "OFFLINE":
+			return []Action{with(Start, "")}
+		}
+	}
+	return nil
+}
+
+// Apply executes the plan. Actions on different resources run concurrently, up to the Concurrency limit,
+// while actions on the same resource run in order and stop at the first failure.
+// The returned error joins all action failures.
+func (r *Reconciler) Apply(ctx context.Context, plan Plan) ([]Result, error) {
+	// Group actions by resource, preserving the plan order.
+	var order []string
+	groups := map[string][]int{}
+	for i, action := range plan.Actions {
+		key := action.key()
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], i)
+	}
+
+	concurrency := r.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	results := make([]Result, len(plan.Actions))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for _, key := range order {
+		wg.Add(1)
+		go func(indices []int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			var failed error
+			for j, i := range indices {
+				action := plan.Actions[i]
+				if failed != nil {
+					results[i] = Result{action, fmt.Errorf("skipped after earlier failure: %w", failed)}
+					continue
+				}
+				// The next action on the resource (e.g., a stop after a create) fails unless this one has finished.
+				failed = r.apply(ctx, action, r.Wait || j < len(indices)-1)
+				results[i] = Result{action, failed}
+			}
+		}(groups[key])
+	}
+	wg.Wait()
+
+	var errs []error
+	for _, result := range results {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", result.Action, result.Err))
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+// apply applies a single action, waiting for the resource to reach its expected status afterwards if wait is set.
+func (r *Reconciler) apply(ctx context.Context, action Action, wait bool) error {
+	if r.DryRun {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	switch action.Kind {
+	case ServerKind:
+		return r.applyServer(ctx, action, wait)
+	case ApplicationKind:
+		return r.applyApplication(ctx, action, wait)
+	}
+	return fmt.Errorf("unknown resource kind %q", action.Kind)
+}
+
+func (r *Reconciler) applyServer(ctx context.Context, action Action, wait bool) error {
+	cmd := virtual.ServerCommandInput{Cluster: action.Cluster, Id: action.Name, Namespace: action.Namespace}
+	if action.await != "" {
+		_, err := waiter.Server(
+			ctx,
+			r.Servers,
+			virtual.GetServerParams{Cluster: cmd.Cluster, Id: cmd.Id, Namespace: cmd.Namespace},
+			r.WaitOptions,
+			action.await,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	var err error
+	var target string
+	switch action.Op {
+	case Create:
+		var server *virtual.VirtualServerDetailsItem
+		server, err = r.Servers.CreateServer(ctx, action.server.Input)
+		if err == nil && cmd.Namespace == "" {
+			cmd.Namespace = server.GetNamespace()
+		}
+		target = "ONLINE"
+	case Start:
+		_, err = r.Servers.StartServer(ctx, cmd)
+		target = "ONLINE"
+	case Stop:
+		_, err = r.Servers.StopServer(ctx, cmd)
+		target = "OFFLINE"
+	case Destroy:
+		_, err = r.Servers.DestroyServer(
+			ctx,
+			&virtual.DestroyServerParams{Cluster: cmd.Cluster, Id: cmd.Id, Namespace: cmd.Namespace},
+		)
+		target = waiter.Gone
+	default:
+		return fmt.Errorf("unknown operation %q", action.Op)
+	}
+
+	if err != nil || !wait {
+		return err
+	}
+	_, err = waiter.Server(
+		ctx,
+		r.Servers,
+		virtual.GetServerParams{Cluster: cmd.Cluster, Id: cmd.Id, Namespace: cmd.Namespace},
+		r.WaitOptions,
+		target,
+	)
+	return err
+}
+
+func (r *Reconciler) applyApplication(ctx context.Context, action Action, wait bool) error {
+	cmd := applications.ApplicationsApiCommandRequest{Cluster: action.Cluster, Id: action.Name}
+	if action.await != "" {
+		_, err := waiter.Application(
+			ctx,
+			r.Applications,
+			applications.GetApplicationDetailsParams{Cluster: cmd.Cluster, Id: cmd.Id},
+			r.WaitOptions,
+			action.await,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	var err error
+	var target string
+	switch action.Op {
+	case Create:
+		if action.app.Catalog != nil {
+			_, err = r.Applications.CreateCatalogApplication(ctx, *action.app.Catalog)
+		} else {
+			_, err = r.Applications.CreateCustomApplication(ctx, *action.app.Custom)
+		}
+		target = "ONLINE"
+	case Start:
+		_, err = r.Applications.StartApplication(ctx, cmd)
+		target = "ONLINE"
+	case Stop:
+		_, err = r.Applications.StopApplication(ctx, cmd)
+		target = "OFFLINE"
+	case Destroy:
+		_, err = r.Applications.DestroyApplication(
+			ctx,
+			&applications.DestroyApplicationParams{Cluster: cmd.Cluster, Id: cmd.Id},
+		)
+		target = waiter.Gone
+	default:
+		return fmt.Errorf("unknown operation %q", action.Op)
+	}
+
+	if err != nil || !wait {
+		return err
+	}
+	_, err = waiter.Application(
+		ctx,
+		r.Applications,
+		applications.GetApplicationDetailsParams{Cluster: cmd.Cluster, Id: cmd.Id},
+		r.WaitOptions,
+		target,
+	)
+	return err
+}
+
+func state(s State) State {
+	if s == "" {
+		return Running
+	}
+	return s
+}
@@ -0,0 +1,310 @@
+package reconcile_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/denvrtest"
+	"github.com/denvrdata/go-denvr/reconcile"
+	"github.com/denvrdata/go-denvr/waiter"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeAPI is a minimal in-memory implementation of the servers and applications endpoints.
+// Servers move from PENDING, STARTING or STOPPING to their next status each time they are fetched,
+// and can only be started once OFFLINE or stopped once ONLINE.
+type fakeAPI struct {
+	sync.Mutex
+	servers map[string]string
+	apps    map[string]string
+	calls   []string
+}
+
+func (f *fakeAPI) handler() http.Handler {
+	write := func(resp http.ResponseWriter, status int, body any) {
+		resp.WriteHeader(status)
+		json.NewEncoder(resp).Encode(body)
+	}
+	notFound := map[string]any{"success": false, "error": map[string]any{"code": 404, "message": "not found"}}
+
+	item := func(cluster, id, status string) map[string]string {
+		return map[string]string{"cluster": cluster, "id": id, "status": status, "namespace": "denvr"}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(
+		"/api/v1/servers/virtual/GetServers",
+		func(resp http.ResponseWriter, req *http.Request) {
+			f.Lock()
+			defer f.Unlock()
+			items := []map[string]string{}
+			for id, status := range f.servers {
+				items = append(items, item("Msc1", id, status))
+			}
+			write(resp, http.StatusOK, map[string]any{"items": items})
+		},
+	)
+	mux.HandleFunc(
+		"/api/v1/servers/virtual/GetServer",
+		func(resp http.ResponseWriter, req *http.Request) {
+			f.Lock()
+			defer f.Unlock()
+			id := req.URL.Query().Get("Id")
+			if status, ok := f.servers[id]; ok {
+				write(resp, http.StatusOK, item("Msc1", id, status))
+				switch status {
+				case "PENDING", "STARTING":
+					f.servers[id] = "ONLINE"
+				case "STOPPING":
+					f.servers[id] = "OFFLINE"
+				}
+			} else {
+				write(resp, http.StatusNotFound, notFound)
+			}
+		},
+	)
+	conflict := map[string]any{"success": false, "error": map[string]any{"code": 409, "message": "invalid status"}}
+	command := func(op string, apply func(id string) bool) http.HandlerFunc {
+		return func(resp http.ResponseWriter, req *http.Request) {
+			f.Lock()
+			defer f.Unlock()
+			var body struct {
+				Id   string `json:"id"`
+				Name string `json:"name"`
+			}
+			if req.Body != nil {
+				json.NewDecoder(req.Body).Decode(&body)
+			}
+			id := body.Id + body.Name + req.URL.Query().Get("Id")
+			f.calls = append(f.calls, op+" "+id)
+			if !apply(id) {
+				write(resp, http.StatusConflict, conflict)
+				return
+			}
+			write(resp, http.StatusOK, item("Msc1", id, "PENDING"))
+		}
+	}
+	transition := func(statuses map[string]string, from, to string) func(id string) bool {
+		return func(id string) bool {
+			if from != "" && statuses[id] != from {
+				return false
+			}
+			statuses[id] = to
+			return true
+		}
+	}
+	mux.HandleFunc("/api/v1/servers/virtual/CreateServer", command("CreateServer", transition(f.servers, "", "PENDING")))
+	mux.HandleFunc("/api/v1/servers/virtual/StartServer", command("StartServer", transition(f.servers, "OFFLINE", "STARTING")))
+	mux.HandleFunc("/api/v1/servers/virtual/StopServer", command("StopServer", transition(f.servers, "ONLINE", "STOPPING")))
+	mux.HandleFunc("/api/v1/servers/virtual/DestroyServer", command("DestroyServer", func(id string) bool { delete(f.servers, id); return true }))
+
+	mux.HandleFunc(
+		"/api/v1/servers/applications/GetApplications",
+		func(resp http.ResponseWriter, req *http.Request) {
+			f.Lock()
+			defer f.Unlock()
+			items := []map[string]string{}
+			for id, status := range f.apps {
+				items = append(items, item("Msc1", id, status))
+			}
+			write(resp, http.StatusOK, map[string]any{"items": items})
+		},
+	)
+	mux.HandleFunc(
+		"/api/v1/servers/applications/GetApplicationDetails",
+		func(resp http.ResponseWriter, req *http.Request) {
+			f.Lock()
+			defer f.Unlock()
+			id := req.URL.Query().Get("Id")
+			if status, ok := f.apps[id]; ok {
+				write(resp, http.StatusOK, map[string]any{"instanceDetails": item("Msc1", id, status)})
+			} else {
+				write(resp, http.StatusNotFound, notFound)
+			}
+		},
+	)
+	mux.HandleFunc("/api/v1/servers/applications/CreateCatalogApplication", command("CreateCatalogApplication", transition(f.apps, "", "ONLINE")))
+	mux.HandleFunc("/api/v1/servers/applications/CreateCustomApplication", command("CreateCustomApplication", transition(f.apps, "", "ONLINE")))
+	mux.HandleFunc("/api/v1/servers/applications/StartApplication", command("StartApplication", transition(f.apps, "", "ONLINE")))
+	mux.HandleFunc("/api/v1/servers/applications/StopApplication", command("StopApplication", transition(f.apps, "", "OFFLINE")))
+	mux.HandleFunc("/api/v1/servers/applications/DestroyApplication", command("DestroyApplication", func(id string) bool { delete(f.apps, id); return true }))
+	return mux
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func TestReconcile(t *testing.T) {
+	api := &fakeAPI{
+		servers: map[string]string{"keep": "ONLINE", "wake": "OFFLINE", "sleep": "ONLINE", "old": "ONLINE"},
+		apps:    map[string]string{"jupyter": "ONLINE", "stale": "OFFLINE"},
+	}
+	server := denvrtest.Serve(t, api.handler())
+
+	r := &reconcile.Reconciler{
+		Servers:      server.Virtual(),
+		Applications: server.Applications(),
+		Concurrency:  2,
+		Wait:         true,
+		WaitOptions:  waiter.Options{Interval: 10 * time.Millisecond, Timeout: 5 * time.Second},
+	}
+
+	vm := func(name string, state reconcile.State) reconcile.Server {
+		return reconcile.Server{
+			Input: virtual.CreateVirtualServerInput{Cluster: "Msc1", Vpc: "denvr", Name: ptr(name), Configuration: "A100_40GB_PCIe_1x"},
+			State: state,
+		}
+	}
+	desired := reconcile.Desired{
+		Servers: []reconcile.Server{
+			vm("keep", reconcile.Running),
+			vm("wake", reconcile.Running),
+			vm("sleep", reconcile.Stopped),
+			vm("new", ""),
+		},
+		Applications: []reconcile.Application{
+			{
+				Catalog: &applications.ApplicationsApiCreateRequest{
+					Cluster:                       "Msc1",
+					Name:                          "jupyter",
+					ApplicationCatalogItemName:    "jupyter-notebook",
+					ApplicationCatalogItemVersion: "python-3.11.9",
+				},
+				State: reconcile.Stopped,
+			},
+			{
+				Custom: &applications.ApplicationsApiCustomApiCreateRequest{
+					Cluster:  "Msc1",
+					Name:     "nginx",
+					ImageUrl: "docker.io/library/nginx:latest",
+				},
+			},
+		},
+		Prune: true,
+	}
+
+	plan, err := r.Plan(context.TODO(), desired)
+	assert.NoError(t, err)
+
+	actions := []string{}
+	for _, action := range plan.Actions {
+		actions = append(actions, action.String())
+	}
+	assert.Equal(
+		t,
+		[]string{
+			"start server Msc1/wake",
+			"stop server Msc1/sleep",
+			"create server Msc1/new",
+			"destroy server Msc1/old",
+			"stop application Msc1/jupyter",
+			"create application Msc1/nginx",
+			"destroy application Msc1/stale",
+		},
+		actions,
+	)
+
+	t.Run(
+		"DryRun",
+		func(t *testing.T) {
+			dry := *r
+			dry.DryRun = true
+			results, err := dry.Apply(context.TODO(), plan)
+			assert.NoError(t, err)
+			assert.Len(t, results, len(plan.Actions))
+			assert.Empty(t, api.calls)
+		},
+	)
+
+	t.Run(
+		"Apply",
+		func(t *testing.T) {
+			results, err := r.Apply(context.TODO(), plan)
+			assert.NoError(t, err)
+			for _, result := range results {
+				assert.NoError(t, result.Err, result.Action.String())
+			}
+			assert.ElementsMatch(
+				t,
+				[]string{
+					"StartServer wake",
+					"StopServer sleep",
+					"CreateServer new",
+					"DestroyServer old",
+					"StopApplication jupyter",
+					"CreateCustomApplication nginx",
+					"DestroyApplication stale",
+				},
+				api.calls,
+			)
+
+			// Applying again should be a no-op
+			plan, err := r.Plan(context.TODO(), desired)
+			assert.NoError(t, err)
+			assert.True(t, plan.Empty())
+		},
+	)
+
+	t.Run(
+		"Converging",
+		func(t *testing.T) {
+			api := &fakeAPI{servers: map[string]string{"stopping": "STOPPING", "restart": "STOPPING", "starting": "STARTING"}}
+			server := denvrtest.Serve(t, api.handler())
+
+			// Without Wait, actions still wait for the resource between steps
+			r := &reconcile.Reconciler{
+				Servers:     server.Virtual(),
+				WaitOptions: waiter.Options{Interval: 10 * time.Millisecond, Timeout: 5 * time.Second},
+			}
+			desired := reconcile.Desired{
+				Servers: []reconcile.Server{
+					vm("stopping", reconcile.Stopped),
+					vm("restart", reconcile.Running),
+					vm("starting", reconcile.Stopped),
+					vm("new", reconcile.Stopped),
+				},
+			}
+			plan, err := r.Plan(context.TODO(), desired)
+			assert.NoError(t, err)
+			actions := []string{}
+			for _, action := range plan.Actions {
+				actions = append(actions, action.String())
+			}
+			// The STOPPING server is already converging
+			assert.Equal(
+				t,
+				[]string{
+					"start server Msc1/restart",
+					"stop server Msc1/starting",
+					"create server Msc1/new",
+					"stop server Msc1/new",
+				},
+				actions,
+			)
+
+			_, err = r.Apply(context.TODO(), plan)
+			assert.NoError(t, err)
+			assert.ElementsMatch(
+				t,
+				[]string{"StartServer restart", "StopServer starting", "CreateServer new", "StopServer new"},
+				api.calls,
+			)
+		},
+	)
+
+	t.Run(
+		"InvalidDesired",
+		func(t *testing.T) {
+			_, err := r.Plan(context.TODO(), reconcile.Desired{Servers: []reconcile.Server{vm("", "")}})
+			assert.ErrorContains(t, err, "must have a name")
+
+			_, err = r.Plan(context.TODO(), reconcile.Desired{Applications: []reconcile.Application{{}}})
+			assert.ErrorContains(t, err, "exactly one of Catalog or Custom")
+		},
+	)
+}
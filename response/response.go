@@ -2,6 +2,7 @@ package response
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -13,6 +14,33 @@ type ErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// StatusError is returned by ParseResponse when the server responds with a 4xx or 5xx status code.
+type StatusError struct {
+	StatusCode int
+	Status     string
+	Message    string
+}
+
+func (e *StatusError) Error() string {
+	if e.Message == "" {
+		return e.Status
+	}
+	return fmt.Sprintf("%s - %s", e.Status, e.Message)
+}
+
+// IsStatus reports whether err is a StatusError with one of the given status codes.
+func IsStatus(err error, codes ...int) bool {
+	var serr *StatusError
+	if errors.As(err, &serr) {
+		for _, code := range codes {
+			if serr.StatusCode == code {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // Response represents a generic response from our Denvr API Server which unwraps either a result or an error.
 type Response[T any] struct {
 	Result  *T             `json:"result"`
@@ -37,11 +65,11 @@ func ParseResponse[T any](rsp *http.Response) (*T, error) {
 	// At this point we've either extracted the additona error message details or not
 	// and should not proceed any further.
 	if 400 <= rsp.StatusCode {
-		if resp.Error == nil {
-			return nil, fmt.Errorf(rsp.Status)
-		} else {
-			return nil, fmt.Errorf("%s - %s", rsp.Status, resp.Error.Message)
+		serr := &StatusError{StatusCode: rsp.StatusCode, Status: rsp.Status}
+		if resp.Error != nil {
+			serr.Message = resp.Error.Message
 		}
+		return nil, serr
 	}
 
 	// If we fail to parse the Response structure then we should fallback to parsing the passed in type.
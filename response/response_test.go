@@ -2,6 +2,8 @@ package response_test
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"testing"
@@ -158,6 +160,37 @@ func TestParseResponse(t *testing.T) {
 	}
 }
 
+func TestStatusError(t *testing.T) {
+	rsp := &http.Response{
+		StatusCode: http.StatusConflict,
+		Status:     "409 Conflict",
+		Body: io.NopCloser(bytes.NewReader([]byte(`{
+			"result": null,
+			"success": false,
+			"error": {"code": 409, "message": "Insufficient capacity"}
+		}`))),
+	}
+
+	_, err := response.ParseResponse[TestStruct](rsp)
+
+	var serr *response.StatusError
+	if !errors.As(err, &serr) {
+		t.Fatalf("ParseResponse() error = %v, want *response.StatusError", err)
+	}
+	if serr.StatusCode != http.StatusConflict || serr.Message != "Insufficient capacity" {
+		t.Errorf("ParseResponse() error = %+v", serr)
+	}
+	if err.Error() != "409 Conflict - Insufficient capacity" {
+		t.Errorf("ParseResponse() error message = %q", err.Error())
+	}
+	if !response.IsStatus(fmt.Errorf("wrapped: %w", err), http.StatusNotFound, http.StatusConflict) {
+		t.Errorf("IsStatus() = false, want true")
+	}
+	if response.IsStatus(err, http.StatusNotFound) {
+		t.Errorf("IsStatus() = true, want false")
+	}
+}
+
 // Helper function to create a string pointer
 func strPtr(s string) *string {
 	return &s
@@ -0,0 +1,90 @@
+package result
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// ErrAbsent is the panic value of Optional.Unwrap when there's no value.
+var ErrAbsent = errors.New("optional value is absent or null")
+
+// Optional is a field value which is either absent, null or set, for fields where null and absent mean different things.
+// The zero value is absent.
+//
+// Generated fields are a *Optional[T] tagged with omitempty, as encoding/json can only omit zero structs with
+// the omitzero tag option from Go 1.24: a nil field is absent, and Null or Some are sent as null or the value.
+// Decoding null into a pointer field leaves it nil, so only values decoded into an Optional directly keep null apart.
+type Optional[T any] struct {
+	value T
+	set   bool
+	null  bool
+}
+
+// Some returns an Optional with the value set.
+func Some[T any](value T) Optional[T] {
+	return Optional[T]{value: value, set: true}
+}
+
+// Null returns an explicitly null Optional.
+func Null[T any]() Optional[T] {
+	return Optional[T]{set: true, null: true}
+}
+
+// Get returns the value and whether it's set (i.e., neither absent nor null).
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.Ok()
+}
+
+// Or returns the value if set, or fallback otherwise.
+func (o Optional[T]) Or(fallback T) T {
+	if o.Ok() {
+		return o.value
+	}
+	return fallback
+}
+
+// Unwrap returns the value, panicking with ErrAbsent if it's absent or null.
+func (o Optional[T]) Unwrap() T {
+	if o.Ok() {
+		return o.value
+	}
+	panic(ErrAbsent)
+}
+
+// Ok reports whether the value is set.
+func (o Optional[T]) Ok() bool {
+	return o.set && !o.null
+}
+
+// IsNull reports whether the value is explicitly null.
+func (o Optional[T]) IsNull() bool {
+	return o.set && o.null
+}
+
+// IsZero reports whether the value is absent, so fields tagged with omitzero are omitted.
+func (o Optional[T]) IsZero() bool {
+	return !o.set
+}
+
+// MarshalJSON encodes absent and null values as null, so absent fields need to be omitted (e.g., by a nil pointer).
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.Ok() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON is only called for fields present in the JSON, so a missing field stays absent.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = Null[T]()
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*o = Some(value)
+	return nil
+}
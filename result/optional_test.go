@@ -0,0 +1,84 @@
+package result_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/denvrdata/go-denvr/result"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptional(t *testing.T) {
+	t.Run(
+		"Some",
+		func(t *testing.T) {
+			opt := result.Some("Msc1")
+			value, ok := opt.Get()
+			assert.True(t, ok)
+			assert.Equal(t, "Msc1", value)
+			assert.Equal(t, "Msc1", opt.Or("Hou1"))
+			assert.Equal(t, "Msc1", opt.Unwrap())
+			assert.False(t, opt.IsNull())
+			assert.False(t, opt.IsZero())
+		},
+	)
+	t.Run(
+		"Null",
+		func(t *testing.T) {
+			opt := result.Null[string]()
+			assert.False(t, opt.Ok())
+			assert.True(t, opt.IsNull())
+			assert.False(t, opt.IsZero())
+			assert.Equal(t, "Hou1", opt.Or("Hou1"))
+			assert.PanicsWithValue(t, result.ErrAbsent, func() { opt.Unwrap() })
+		},
+	)
+	t.Run(
+		"Absent",
+		func(t *testing.T) {
+			var opt result.Optional[string]
+			assert.False(t, opt.Ok())
+			assert.False(t, opt.IsNull())
+			assert.True(t, opt.IsZero())
+		},
+	)
+}
+
+func TestOptionalJSON(t *testing.T) {
+	type server struct {
+		Rpool result.Optional[string] `json:"rpool"`
+		Gpus  result.Optional[int32]  `json:"gpus"`
+	}
+
+	var absent, null, set server
+	require.NoError(t, json.Unmarshal([]byte(`{}`), &absent))
+	require.NoError(t, json.Unmarshal([]byte(`{"rpool": null, "gpus": null}`), &null))
+	require.NoError(t, json.Unmarshal([]byte(`{"rpool": "reserved-denvr", "gpus": 8}`), &set))
+
+	assert.True(t, absent.Rpool.IsZero())
+	assert.True(t, null.Rpool.IsNull())
+	assert.Equal(t, "reserved-denvr", set.Rpool.Unwrap())
+	assert.Equal(t, int32(8), set.Gpus.Unwrap())
+
+	data, err := json.Marshal(set)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"rpool": "reserved-denvr", "gpus": 8}`, string(data))
+	data, err = json.Marshal(absent)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"rpool": null, "gpus": null}`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"gpus": "eight"}`), &set))
+
+	// Generated fields are pointers, so absent fields are omitted
+	type input struct {
+		Rpool *result.Optional[string] `json:"rpool,omitempty"`
+	}
+	nullRpool := result.Null[string]()
+	someRpool := result.Some("reserved-denvr")
+	for expected, in := range map[string]input{`{}`: {}, `{"rpool": null}`: {&nullRpool}, `{"rpool": "reserved-denvr"}`: {&someRpool}} {
+		data, err := json.Marshal(in)
+		require.NoError(t, err)
+		assert.JSONEq(t, expected, string(data))
+	}
+}
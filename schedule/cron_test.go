@@ -0,0 +1,46 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/denvrdata/go-denvr/schedule"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCron(t *testing.T) {
+	toronto, err := time.LoadLocation("America/Toronto")
+	assert.NoError(t, err)
+
+	// 2024-11-01 is a Friday
+	start := time.Date(2024, 11, 1, 18, 30, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		expr     string
+		from     time.Time
+		expected time.Time
+	}{
+		{"* * * * *", start, time.Date(2024, 11, 1, 18, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", start, time.Date(2024, 11, 1, 18, 45, 0, 0, time.UTC)},
+		{"0 19 * * 1-5", start, time.Date(2024, 11, 1, 19, 0, 0, 0, time.UTC)},
+		{"0 8 * * 1-5", start, time.Date(2024, 11, 4, 8, 0, 0, 0, time.UTC)},
+		{"0 8 * * 7", start, time.Date(2024, 11, 3, 8, 0, 0, 0, time.UTC)},
+		{"0 0 1,15 * *", start, time.Date(2024, 11, 15, 0, 0, 0, 0, time.UTC)},
+		{"30 6 * 2 *", start, time.Date(2025, 2, 1, 6, 30, 0, 0, time.UTC)},
+		{"0 0 13 * 5", start, time.Date(2024, 11, 8, 0, 0, 0, 0, time.UTC)},
+		{"@daily", start, time.Date(2024, 11, 2, 0, 0, 0, 0, time.UTC)},
+		{"0 0 31 2 *", start, time.Time{}},
+		// 2024-11-03 is the end of daylight saving time in Toronto
+		{"0 8 * * *", time.Date(2024, 11, 2, 9, 0, 0, 0, toronto), time.Date(2024, 11, 3, 13, 0, 0, 0, time.UTC)},
+		{"0 8 * * *", time.Date(2024, 11, 1, 9, 0, 0, 0, toronto), time.Date(2024, 11, 2, 12, 0, 0, 0, time.UTC)},
+	} {
+		cron, err := schedule.ParseCron(tc.expr)
+		assert.NoError(t, err, tc.expr)
+		actual := cron.Next(tc.from)
+		assert.True(t, tc.expected.Equal(actual), "%s: expected %v, got %v", tc.expr, tc.expected, actual)
+	}
+
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "5-1 * * * *", "*/0 * * * *", "a * * * *"} {
+		_, err := schedule.ParseCron(expr)
+		assert.Error(t, err, expr)
+	}
+}
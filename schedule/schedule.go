@@ -0,0 +1,367 @@
+// Package schedule starts and stops servers and applications on cron-style schedules
+// (e.g., stopping development servers every weekday evening and starting them again in the morning).
+//
+// Usage:
+//
+//	vc, ac := virtual.NewClient(), applications.NewClient()
+//	s := schedule.Scheduler{
+//		Servers:      &vc,
+//		Applications: &ac,
+//		Rules: []schedule.Rule{
+//			{Name: "evening", Cron: "0 19 * * 1-5", TimeZone: "America/Toronto", Action: schedule.Stop, Match: "dev-*"},
+//			{Name: "morning", Cron: "0 8 * * 1-5", TimeZone: "America/Toronto", Action: schedule.Start, Match: "dev-*"},
+//		},
+//		Store: schedule.FileStore("schedule.json"),
+//	}
+//	err := s.Run(ctx)
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+)
+
+type Kind string
+
+const (
+	Server      Kind = "server"
+	Application Kind = "application"
+)
+
+type Action string
+
+const (
+	Start Action = "start"
+	Stop  Action = "stop"
+)
+
+// Rule starts or stops every resource matching the kind, cluster and name pattern whenever the cron expression fires.
+type Rule struct {
+	// Name uniquely identifies the rule in the persisted state
+	Name string `toml:"name" json:"name"`
+	// Cron is a five field cron expression (see ParseCron)
+	Cron string `toml:"cron" json:"cron"`
+	// TimeZone the cron expression is evaluated in (defaults to UTC)
+	TimeZone string `toml:"timezone" json:"timezone"`
+	Action   Action `toml:"action" json:"action"`
+	// Kind restricts the rule to servers or applications, both are matched if empty
+	Kind Kind `toml:"kind" json:"kind"`
+	// Cluster restricts the rule to a single cluster if set
+	Cluster string `toml:"cluster" json:"cluster"`
+	// Match is an exact name or a glob pattern (see path.Match)
+	Match string `toml:"match" json:"match"`
+}
+
+// State records when each rule last ran, keyed by rule name.
+type State map[string]time.Time
+
+// Store persists State between runs so restarts don't skip or repeat scheduled actions.
+type Store interface {
+	Load() (State, error)
+	Save(State) error
+}
+
+// FileStore persists State as JSON to the given path.
+type FileStore string
+
+// Load returns an empty State if the file doesn't exist yet.
+func (f FileStore) Load() (State, error) {
+	state := State{}
+	data, err := os.ReadFile(string(f))
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return state, json.Unmarshal(data, &state)
+}
+
+// Save writes to a temporary file first, so a crash never leaves a partially written state.
+func (f FileStore) Save(state State) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := string(f) + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, string(f))
+}
+
+// Report describes a single start or stop performed by a rule.
+type Report struct {
+	Rule    string
+	Action  Action
+	Kind    Kind
+	Cluster string
+	Name    string
+	Time    time.Time
+	Err     error
+}
+
+func (r Report) String() string {
+	msg := fmt.Sprintf("%s: %s %s %s/%s", r.Rule, r.Action, r.Kind, r.Cluster, r.Name)
+	if r.Err != nil {
+		msg += fmt.Sprintf(" failed: %v", r.Err)
+	}
+	return msg
+}
+
+// Scheduler evaluates the rules against the provided clients. Either client may be nil to skip that service.
+type Scheduler struct {
+	Servers      virtual.ClientInterface
+	Applications applications.ClientInterface
+	Rules        []Rule
+	// Store persists when each rule last ran. If nil, the state is only kept in memory.
+	Store Store
+	// Interval between checks in Run (defaults to 1 minute)
+	Interval time.Duration
+	// OnReport is called synchronously for every start or stop if set
+	OnReport func(Report)
+	// Now defaults to time.Now, useful for testing
+	Now func() time.Time
+
+	state State
+}
+
+// Run calls Tick every Interval until the context is cancelled.
+// Errors from individual resources are reported to OnReport rather than stopping the loop,
+// so only invalid rules and state persistence failures are returned.
+func (s *Scheduler) Run(ctx context.Context) error {
+	interval := s.Interval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := s.Tick(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick runs every rule which has fired since it last ran and returns what was done.
+// Rules without any recorded state start from now, so a new rule never fires for past times.
+// Multiple missed firings (e.g., while the daemon was down) only result in a single run, and when several rules
+// are due for the same resource only the one which fired most recently is applied (e.g., the morning start
+// rather than the evening stop after a weekend), so resources end up in the state they'd be in without the downtime.
+func (s *Scheduler) Tick(ctx context.Context) ([]Report, error) {
+	now := time.Now()
+	if s.Now != nil {
+		now = s.Now()
+	}
+	if err := Validate(s.Rules); err != nil {
+		return nil, err
+	}
+
+	if s.state == nil {
+		s.state = State{}
+		if s.Store != nil {
+			state, err := s.Store.Load()
+			if err != nil {
+				return nil, err
+			}
+			s.state = state
+		}
+	}
+
+	var due []firing
+	changed := false
+	for _, rule := range s.Rules {
+		last, ok := s.state[rule.Name]
+		if !ok {
+			s.state[rule.Name] = now
+			changed = true
+			continue
+		}
+		// Validate already checked the cron expressions and time zones
+		cron, _ := ParseCron(rule.Cron)
+		loc, _ := time.LoadLocation(rule.TimeZone)
+		var fired time.Time
+		for next := cron.Next(last.In(loc)); !next.IsZero() && !next.After(now); next = cron.Next(next) {
+			fired = next
+		}
+		if fired.IsZero() {
+			continue
+		}
+		due = append(due, firing{rule, fired})
+		s.state[rule.Name] = now
+		changed = true
+	}
+
+	reports := s.apply(ctx, due, now)
+	for _, report := range reports {
+		if s.OnReport != nil {
+			s.OnReport(report)
+		}
+	}
+
+	if changed && s.Store != nil {
+		if err := s.Store.Save(s.state); err != nil {
+			return reports, err
+		}
+	}
+	return reports, nil
+}
+
+// Validate checks the rules have unique names, valid cron expressions, time zones, actions and kinds,
+// and that each is restricted to a cluster or name pattern, so a rule never applies to the whole tenant by accident.
+func Validate(rules []Rule) error {
+	names := map[string]bool{}
+	for i, rule := range rules {
+		if rule.Name == "" {
+			return fmt.Errorf("rule %d: missing name", i)
+		}
+		if names[rule.Name] {
+			return fmt.Errorf("rule %s: duplicate name", rule.Name)
+		}
+		names[rule.Name] = true
+
+		if _, err := ParseCron(rule.Cron); err != nil {
+			return fmt.Errorf("rule %s: %w", rule.Name, err)
+		}
+		if _, err := time.LoadLocation(rule.TimeZone); err != nil {
+			return fmt.Errorf("rule %s: %w", rule.Name, err)
+		}
+		if rule.Action != Start && rule.Action != Stop {
+			return fmt.Errorf("rule %s: unknown action %q", rule.Name, rule.Action)
+		}
+		if rule.Kind != "" && rule.Kind != Server && rule.Kind != Application {
+			return fmt.Errorf("rule %s: unknown kind %q", rule.Name, rule.Kind)
+		}
+		if rule.Match == "" && rule.Cluster == "" {
+			return fmt.Errorf("rule %s: match or cluster is required", rule.Name)
+		}
+		if _, err := path.Match(rule.Match, ""); err != nil {
+			return fmt.Errorf("rule %s: invalid match %q: %w", rule.Name, rule.Match, err)
+		}
+	}
+	return nil
+}
+
+// firing is a due rule along with the most recent time it fired.
+type firing struct {
+	Rule
+	time time.Time
+}
+
+// target is a resource matched by a due rule.
+type target struct {
+	firing
+	kind      Kind
+	cluster   string
+	name      string
+	namespace string
+	status    string
+}
+
+// apply starts or stops every resource matched by the due rules which isn't already in the desired status.
+// Resources matched by several rules only get the action of the most recent firing (or the last rule on ties).
+func (s *Scheduler) apply(ctx context.Context, due []firing, now time.Time) []Report {
+	var reports []Report
+	report := func(f firing, kind Kind, cluster, name string, err error) {
+		reports = append(reports, Report{f.Name, f.Action, kind, cluster, name, now, err})
+	}
+
+	var order []string
+	targets := map[string]target{}
+	add := func(t target) {
+		if !t.matches(t.cluster, t.name) {
+			return
+		}
+		key := string(t.kind) + "/" + t.cluster + "/" + t.name
+		prev, ok := targets[key]
+		if !ok {
+			order = append(order, key)
+		}
+		if !ok || !t.time.Before(prev.time) {
+			targets[key] = t
+		}
+	}
+
+	for _, f := range due {
+		if s.Servers != nil && (f.Kind == "" || f.Kind == Server) {
+			params := &virtual.GetServersParams{}
+			if f.Cluster != "" {
+				params.Cluster = &f.Cluster
+			}
+			rsp, err := s.Servers.GetServers(ctx, params)
+			if err != nil {
+				report(f, Server, f.Cluster, f.Match, err)
+			} else {
+				for _, vm := range rsp.GetItems() {
+					add(target{f, Server, vm.GetCluster(), vm.GetId(), vm.GetNamespace(), vm.GetStatus()})
+				}
+			}
+		}
+
+		if s.Applications != nil && (f.Kind == "" || f.Kind == Application) {
+			rsp, err := s.Applications.GetApplications(ctx)
+			if err != nil {
+				report(f, Application, f.Cluster, f.Match, err)
+			} else {
+				for _, app := range rsp.GetItems() {
+					add(target{f, Application, app.GetCluster(), app.GetId(), "", app.GetStatus()})
+				}
+			}
+		}
+	}
+
+	for _, key := range order {
+		t := targets[key]
+		if !transition(t.Action, t.status) {
+			continue
+		}
+		var err error
+		switch {
+		case t.kind == Server && t.Action == Start:
+			_, err = s.Servers.StartServer(ctx, virtual.ServerCommandInput{Cluster: t.cluster, Id: t.name, Namespace: t.namespace})
+		case t.kind == Server:
+			_, err = s.Servers.StopServer(ctx, virtual.ServerCommandInput{Cluster: t.cluster, Id: t.name, Namespace: t.namespace})
+		case t.Action == Start:
+			_, err = s.Applications.StartApplication(ctx, applications.ApplicationsApiCommandRequest{Cluster: t.cluster, Id: t.name})
+		default:
+			_, err = s.Applications.StopApplication(ctx, applications.ApplicationsApiCommandRequest{Cluster: t.cluster, Id: t.name})
+		}
+		report(t.firing, t.kind, t.cluster, t.name, err)
+	}
+
+	return reports
+}
+
+func (r Rule) matches(cluster, name string) bool {
+	if r.Cluster != "" && r.Cluster != cluster {
+		return false
+	}
+	if r.Match == "" {
+		return true
+	}
+	ok, _ := path.Match(r.Match, name)
+	return ok
+}
+
+// transition reports whether a resource in the given status needs the action.
+// Resources which are still pending or in an error state are left alone.
+func transition(action Action, status string) bool {
+	if action == Start {
+		return status == "OFFLINE"
+	}
+	return status == "ONLINE"
+}
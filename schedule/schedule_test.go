@@ -0,0 +1,143 @@
+package schedule_test
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/denvrdata/go-denvr/denvrtest"
+	"github.com/denvrdata/go-denvr/schedule"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileStore(t *testing.T) {
+	store := schedule.FileStore(filepath.Join(t.TempDir(), "state.json"))
+
+	state, err := store.Load()
+	assert.NoError(t, err)
+	assert.Empty(t, state)
+
+	now := time.Date(2024, 11, 1, 18, 30, 0, 0, time.UTC)
+	assert.NoError(t, store.Save(schedule.State{"evening": now}))
+	state, err = store.Load()
+	assert.NoError(t, err)
+	assert.True(t, now.Equal(state["evening"]))
+}
+
+func TestScheduler(t *testing.T) {
+	var calls []string
+	server := denvrtest.NewServer(t)
+	server.Reply(
+		"/api/v1/servers/virtual/GetServers",
+		http.StatusOK,
+		`{
+			"items": [
+				{"id": "dev-1", "cluster": "Msc1", "namespace": "denvr", "status": "ONLINE"},
+				{"id": "dev-2", "cluster": "Msc1", "namespace": "denvr", "status": "OFFLINE"},
+				{"id": "prod-1", "cluster": "Msc1", "namespace": "denvr", "status": "ONLINE"}
+			]
+		}`,
+	)
+	for _, op := range []string{"StartServer", "StopServer"} {
+		server.HandleFunc(
+			"/api/v1/servers/virtual/"+op,
+			func(resp http.ResponseWriter, req *http.Request) {
+				calls = append(calls, op)
+				resp.Write([]byte(`{}`))
+			},
+		)
+	}
+	server.Reply("/api/v1/servers/applications/GetApplications", http.StatusOK, `{"items": [{"id": "dev-notebook", "cluster": "Hou1", "status": "ONLINE"}]}`)
+	server.HandleFunc(
+		"/api/v1/servers/applications/StopApplication",
+		func(resp http.ResponseWriter, req *http.Request) {
+			calls = append(calls, "StopApplication")
+			resp.WriteHeader(http.StatusInternalServerError)
+			resp.Write([]byte(`{"error": {"message": "boom"}}`))
+		},
+	)
+	store := schedule.FileStore(filepath.Join(t.TempDir(), "state.json"))
+	now := time.Date(2024, 11, 1, 22, 30, 0, 0, time.UTC)
+	var reported []string
+	s := schedule.Scheduler{
+		Servers:      server.Virtual(),
+		Applications: server.Applications(),
+		Rules: []schedule.Rule{
+			// 19:00 in Toronto is 23:00 UTC
+			{Name: "evening", Cron: "0 19 * * 1-5", TimeZone: "America/Toronto", Action: schedule.Stop, Match: "dev-*"},
+			{Name: "morning", Cron: "0 8 * * 1-5", TimeZone: "America/Toronto", Action: schedule.Start, Kind: schedule.Server, Match: "dev-*"},
+		},
+		Store:    store,
+		Now:      func() time.Time { return now },
+		OnReport: func(r schedule.Report) { reported = append(reported, r.String()) },
+	}
+
+	// The first tick only records the starting point
+	reports, err := s.Tick(context.TODO())
+	assert.NoError(t, err)
+	assert.Empty(t, reports)
+
+	now = now.Add(time.Hour)
+	reports, err = s.Tick(context.TODO())
+	assert.NoError(t, err)
+	assert.Len(t, reports, 2)
+	assert.Equal(t, []string{"StopServer", "StopApplication"}, calls)
+	assert.Equal(t, "evening: stop server Msc1/dev-1", reported[0])
+	assert.ErrorContains(t, reports[1].Err, "boom")
+
+	// Running again shouldn't repeat the actions
+	reports, err = s.Tick(context.TODO())
+	assert.NoError(t, err)
+	assert.Empty(t, reports)
+
+	// A new scheduler should pick up where the last left off, even after missing a few runs.
+	// Both rules fired on Monday but the evening stop is the most recent, so dev-2 isn't started.
+	calls = nil
+	restarted := schedule.Scheduler{Servers: s.Servers, Rules: s.Rules, Store: store}
+	restarted.Now = func() time.Time { return now.Add(73 * time.Hour) }
+	reports, err = restarted.Tick(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"StopServer"}, calls)
+	assert.Len(t, reports, 1)
+	assert.Equal(t, "evening", reports[0].Rule)
+
+	state, err := store.Load()
+	assert.NoError(t, err)
+	assert.True(t, now.Add(73*time.Hour).Equal(state["morning"]))
+
+	// When the morning start is the most recent firing, servers are started rather than stopped
+	calls = nil
+	friday := time.Date(2024, 11, 1, 12, 0, 0, 0, time.UTC)
+	store = schedule.FileStore(filepath.Join(t.TempDir(), "state.json"))
+	assert.NoError(t, store.Save(schedule.State{"evening": friday, "morning": friday}))
+	restarted = schedule.Scheduler{Servers: s.Servers, Rules: s.Rules, Store: store}
+	restarted.Now = func() time.Time { return time.Date(2024, 11, 4, 16, 30, 0, 0, time.UTC) }
+	reports, err = restarted.Tick(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"StartServer"}, calls)
+	assert.Equal(t, "morning: start server Msc1/dev-2", reports[0].String())
+}
+
+func TestValidate(t *testing.T) {
+	valid := schedule.Rule{Name: "evening", Cron: "0 19 * * 1-5", Action: schedule.Stop, Match: "dev-*"}
+	assert.NoError(t, schedule.Validate([]schedule.Rule{valid, {Name: "hou1", Cron: "0 8 * * *", Action: schedule.Start, Cluster: "Hou1"}}))
+
+	for name, tc := range map[string]struct {
+		rules []schedule.Rule
+		err   string
+	}{
+		"Cron":      {[]schedule.Rule{{Name: "bad", Cron: "* *", Action: schedule.Stop, Match: "dev-*"}}, "rule bad"},
+		"Action":    {[]schedule.Rule{{Name: "bad", Cron: "* * * * *", Action: "pause", Match: "dev-*"}}, `unknown action "pause"`},
+		"Tenant":    {[]schedule.Rule{{Name: "all", Cron: "* * * * *", Action: schedule.Stop}}, "rule all: match or cluster is required"},
+		"Name":      {[]schedule.Rule{{Cron: "* * * * *", Action: schedule.Stop, Match: "dev-*"}}, "rule 0: missing name"},
+		"Duplicate": {[]schedule.Rule{valid, valid}, "rule evening: duplicate name"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorContains(t, schedule.Validate(tc.rules), tc.err)
+			_, err := (&schedule.Scheduler{Rules: tc.rules}).Tick(context.TODO())
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package ssh
+
+import (
+	"bufio"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	cryptossh "golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+// Key is a validated public key in authorized_keys format.
+type Key struct {
+	// Authorized is the normalized "<type> <base64> [comment]" line sent in create requests
+	Authorized string
+	Type       string
+	// Fingerprint is the SHA256 fingerprint (e.g., "SHA256:...") shown by ssh-keygen -l
+	Fingerprint string
+	Comment     string
+}
+
+func (k Key) String() string {
+	return k.Authorized
+}
+
+// ParseKey validates a single public key in authorized_keys format.
+func ParseKey(s string) (Key, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "PRIVATE KEY") {
+		return Key{}, errors.New("expected a public key but found a private key")
+	}
+	pub, comment, _, rest, err := cryptossh.ParseAuthorizedKey([]byte(s))
+	if err != nil {
+		return Key{}, fmt.Errorf("invalid public key: %w", err)
+	}
+	if len(strings.TrimSpace(string(rest))) > 0 {
+		return Key{}, errors.New("invalid public key: expected a single key")
+	}
+	return newKey(pub, comment), nil
+}
+
+func newKey(pub cryptossh.PublicKey, comment string) Key {
+	authorized := strings.TrimSpace(string(cryptossh.MarshalAuthorizedKey(pub)))
+	if comment != "" {
+		authorized += " " + comment
+	}
+	return Key{
+		Authorized:  authorized,
+		Type:        pub.Type(),
+		Fingerprint: cryptossh.FingerprintSHA256(pub),
+		Comment:     comment,
+	}
+}
+
+// ParseKeys validates every non-empty, non-comment line (e.g., an authorized_keys file or https://github.com/<user>.keys).
+func ParseKeys(data string) ([]Key, error) {
+	var keys []Key
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, err := ParseKey(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, scanner.Err()
+}
+
+// LoadKeys reads public keys from files. A leading ~ is expanded to the home directory.
+func LoadKeys(paths ...string) ([]Key, error) {
+	var keys []Key
+	for _, path := range paths {
+		if rest, ok := strings.CutPrefix(path, "~/"); ok {
+			home, err := os.UserHomeDir()
+			if err != nil {
+				return nil, err
+			}
+			path = filepath.Join(home, rest)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		found, err := ParseKeys(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		keys = append(keys, found...)
+	}
+	return keys, nil
+}
+
+// AgentKeys returns the public keys held by the ssh-agent listening on SSH_AUTH_SOCK.
+func AgentKeys() ([]Key, error) {
+	sock := os.Getenv("SSH_AUTH_SOCK")
+	if sock == "" {
+		return nil, errors.New("SSH_AUTH_SOCK is not set")
+	}
+	conn, err := net.Dial("unix", sock)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	listed, err := agent.NewClient(conn).List()
+	if err != nil {
+		return nil, err
+	}
+	var keys []Key
+	for _, k := range listed {
+		pub, err := cryptossh.ParsePublicKey(k.Blob)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, newKey(pub, k.Comment))
+	}
+	return keys, nil
+}
+
+// FetchKeys downloads public keys from a URL serving authorized_keys lines (e.g., https://github.com/<user>.keys).
+// A bare "github:<user>" is expanded to the GitHub URL. If client is nil, http.DefaultClient is used.
+// Only https URLs are accepted (including redirects), as the keys grant access to the servers.
+func FetchKeys(ctx context.Context, client *http.Client, rawURL string) ([]Key, error) {
+	if user, ok := strings.CutPrefix(rawURL, "github:"); ok {
+		rawURL = fmt.Sprintf("https://github.com/%s.keys", user)
+	}
+	if err := requireHTTPS(rawURL); err != nil {
+		return nil, err
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	c := *client
+	c.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if err := requireHTTPS(req.URL.String()); err != nil {
+			return fmt.Errorf("redirected: %w", err)
+		}
+		if client.CheckRedirect != nil {
+			return client.CheckRedirect(req, via)
+		}
+		// The default policy of http.Client
+		if len(via) >= 10 {
+			return errors.New("stopped after 10 redirects")
+		}
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: %s", rawURL, rsp.Status)
+	}
+	// Nobody should have megabytes of public keys, so we cap what we read.
+	data, err := io.ReadAll(io.LimitReader(rsp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	return ParseKeys(string(data))
+}
+
+// requireHTTPS rejects any URL which isn't https (e.g., http or file URLs).
+func requireHTTPS(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "https" {
+		return fmt.Errorf("fetching keys from %s: only https URLs are supported", rawURL)
+	}
+	return nil
+}
+
+// Authorized returns the keys in the format expected by the SshKeys fields of create requests.
+func Authorized(keys ...Key) []string {
+	authorized := make([]string, len(keys))
+	for i, k := range keys {
+		authorized[i] = k.Authorized
+	}
+	return authorized
+}
+
+// ValidateKeys checks every key in an SshKeys field before it's submitted.
+func ValidateKeys(keys []string) error {
+	var errs []error
+	for i, k := range keys {
+		if _, err := ParseKey(k); err != nil {
+			errs = append(errs, fmt.Errorf("ssh key %d: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Keypair is a generated key. The private key is never sent to the API.
+type Keypair struct {
+	Public Key
+	// PrivatePEM is the OpenSSH formatted private key, suitable for writing to an IdentityFile
+	PrivatePEM []byte
+	Signer     cryptossh.Signer
+}
+
+// AuthMethod authenticates with the generated private key, for use in a cryptossh.ClientConfig.
+func (k Keypair) AuthMethod() cryptossh.AuthMethod {
+	return cryptossh.PublicKeys(k.Signer)
+}
+
+// GenerateKeypair creates an ed25519 keypair with the given comment.
+func GenerateKeypair(comment string) (Keypair, error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return Keypair{}, err
+	}
+	signer, err := cryptossh.NewSignerFromKey(priv)
+	if err != nil {
+		return Keypair{}, err
+	}
+	sshPub, err := cryptossh.NewPublicKey(pub)
+	if err != nil {
+		return Keypair{}, err
+	}
+	block, err := cryptossh.MarshalPrivateKey(priv, comment)
+	if err != nil {
+		return Keypair{}, err
+	}
+	return Keypair{Public: newKey(sshPub, comment), PrivatePEM: pem.EncodeToMemory(block), Signer: signer}, nil
+}
+
+// WithEphemeralKey returns a copy of input with a newly generated ed25519 key added to its SshKeys,
+// along with the keypair so automation can connect as soon as the server is ONLINE.
+func WithEphemeralKey(input virtual.CreateVirtualServerInput) (virtual.CreateVirtualServerInput, Keypair, error) {
+	name := "server"
+	if input.Name != nil {
+		name = *input.Name
+	}
+	keypair, err := GenerateKeypair(fmt.Sprintf("denvr-ephemeral-%s", name))
+	if err != nil {
+		return input, Keypair{}, err
+	}
+	input.SshKeys = append(append([]string{}, input.SshKeys...), keypair.Public.Authorized)
+	return input, keypair, nil
+}
@@ -0,0 +1,145 @@
+package ssh_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/ssh"
+	"github.com/stretchr/testify/assert"
+	cryptossh "golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+func TestParseKey(t *testing.T) {
+	keypair, err := ssh.GenerateKeypair("test@denvr")
+	assert.NoError(t, err)
+
+	key, err := ssh.ParseKey("  " + keypair.Public.Authorized + "\n")
+	assert.NoError(t, err)
+	assert.Equal(t, keypair.Public, key)
+	assert.Equal(t, "ssh-ed25519", key.Type)
+	assert.Equal(t, "test@denvr", key.Comment)
+	assert.True(t, strings.HasPrefix(key.Fingerprint, "SHA256:"))
+
+	_, err = ssh.ParseKey("ssh-ed25519 not-base64")
+	assert.ErrorContains(t, err, "invalid public key")
+	_, err = ssh.ParseKey(string(keypair.PrivatePEM))
+	assert.EqualError(t, err, "expected a public key but found a private key")
+	_, err = ssh.ParseKey(keypair.Public.Authorized + "\n" + keypair.Public.Authorized)
+	assert.EqualError(t, err, "invalid public key: expected a single key")
+
+	assert.NoError(t, ssh.ValidateKeys([]string{keypair.Public.Authorized}))
+	assert.EqualError(
+		t,
+		ssh.ValidateKeys([]string{keypair.Public.Authorized, "nope"}),
+		"ssh key 1: invalid public key: ssh: no key found",
+	)
+}
+
+func TestLoadKeys(t *testing.T) {
+	a, _ := ssh.GenerateKeypair("a")
+	b, _ := ssh.GenerateKeypair("b")
+
+	path := filepath.Join(t.TempDir(), "authorized_keys")
+	content := "# team keys\n" + a.Public.Authorized + "\n\n" + b.Public.Authorized + "\n"
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	keys, err := ssh.LoadKeys(path)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{a.Public.Authorized, b.Public.Authorized}, ssh.Authorized(keys...))
+
+	assert.NoError(t, os.WriteFile(path, []byte(a.Public.Authorized+"\nbad\n"), 0o600))
+	_, err = ssh.LoadKeys(path)
+	assert.ErrorContains(t, err, "line 2: invalid public key")
+}
+
+func TestFetchKeys(t *testing.T) {
+	a, _ := ssh.GenerateKeypair("")
+	server := httptest.NewTLSServer(
+		http.HandlerFunc(
+			func(resp http.ResponseWriter, req *http.Request) {
+				if req.URL.Path == "/insecure.keys" {
+					http.Redirect(resp, req, "http://"+req.Host+"/octocat.keys", http.StatusFound)
+					return
+				}
+				if req.URL.Path != "/octocat.keys" {
+					resp.WriteHeader(http.StatusNotFound)
+					return
+				}
+				resp.Write([]byte(a.Public.Authorized + "\n"))
+			},
+		),
+	)
+	defer server.Close()
+
+	keys, err := ssh.FetchKeys(context.TODO(), server.Client(), server.URL+"/octocat.keys")
+	assert.NoError(t, err)
+	assert.Equal(t, []ssh.Key{a.Public}, keys)
+
+	_, err = ssh.FetchKeys(context.TODO(), server.Client(), server.URL+"/missing.keys")
+	assert.ErrorContains(t, err, "404 Not Found")
+
+	// The keys grant access to the servers, so they must not be fetched in the clear
+	for _, u := range []string{"http://github.com/octocat.keys", "file:///etc/passwd", "github.com/octocat.keys"} {
+		_, err = ssh.FetchKeys(context.TODO(), server.Client(), u)
+		assert.ErrorContains(t, err, "only https URLs are supported", u)
+	}
+	_, err = ssh.FetchKeys(context.TODO(), server.Client(), server.URL+"/insecure.keys")
+	assert.ErrorContains(t, err, "redirected: fetching keys from http://")
+}
+
+func TestAgentKeys(t *testing.T) {
+	keypair, _ := ssh.GenerateKeypair("agent")
+	priv, err := cryptossh.ParseRawPrivateKey(keypair.PrivatePEM)
+	assert.NoError(t, err)
+
+	keyring := agent.NewKeyring()
+	assert.NoError(t, keyring.Add(agent.AddedKey{PrivateKey: priv, Comment: "agent"}))
+
+	sock := filepath.Join(t.TempDir(), "agent.sock")
+	listener, err := net.Listen("unix", sock)
+	assert.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go agent.ServeAgent(keyring, conn)
+		}
+	}()
+
+	t.Setenv("SSH_AUTH_SOCK", sock)
+	keys, err := ssh.AgentKeys()
+	assert.NoError(t, err)
+	assert.Equal(t, []ssh.Key{keypair.Public}, keys)
+
+	t.Setenv("SSH_AUTH_SOCK", "")
+	_, err = ssh.AgentKeys()
+	assert.EqualError(t, err, "SSH_AUTH_SOCK is not set")
+}
+
+func TestWithEphemeralKey(t *testing.T) {
+	existing, _ := ssh.GenerateKeypair("existing")
+	name := "trainer"
+	input := virtual.CreateVirtualServerInput{Name: &name, SshKeys: []string{existing.Public.Authorized}}
+
+	updated, keypair, err := ssh.WithEphemeralKey(input)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{existing.Public.Authorized}, input.SshKeys)
+	assert.Equal(t, []string{existing.Public.Authorized, keypair.Public.Authorized}, updated.SshKeys)
+	assert.Equal(t, "denvr-ephemeral-trainer", keypair.Public.Comment)
+
+	signer, err := cryptossh.ParsePrivateKey(keypair.PrivatePEM)
+	assert.NoError(t, err)
+	assert.Equal(t, keypair.Public.Fingerprint, cryptossh.FingerprintSHA256(signer.PublicKey()))
+	assert.NotNil(t, keypair.AuthMethod())
+}
@@ -0,0 +1,330 @@
+// Package ssh connects to servers and applications using golang.org/x/crypto/ssh.
+//
+// Usage:
+//
+//	target, err := ssh.ResolveServer(ctx, &client, virtual.GetServerParams{Id: "my-vm", Namespace: "denvr", Cluster: "Msc1"})
+//	conn, err := ssh.Dial(ctx, target, &cryptossh.ClientConfig{Auth: auth, HostKeyCallback: callback}, waiter.Options{})
+//	defer conn.Close()
+//	out, err := ssh.Output(conn, "nvidia-smi")
+package ssh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/waiter"
+	cryptossh "golang.org/x/crypto/ssh"
+)
+
+// DefaultPort is used when a Target doesn't specify one.
+const DefaultPort = 22
+
+// Target is the address and user of a server or application.
+type Target struct {
+	Kind    string
+	Name    string
+	Cluster string
+	// Host is the public IP or DNS name
+	Host string
+	// PrivateHost is the private IP, which is only reachable from within the VPC
+	PrivateHost string
+	User        string
+	Port        int
+	// Private selects PrivateHost rather than Host in Addr
+	Private bool
+}
+
+// Addr returns the host:port to connect to.
+func (t Target) Addr() string {
+	host := t.Host
+	if t.Private {
+		host = t.PrivateHost
+	}
+	port := t.Port
+	if port == 0 {
+		port = DefaultPort
+	}
+	return net.JoinHostPort(host, strconv.Itoa(port))
+}
+
+// ImageUsers maps the prefix of an OS image name (e.g., Ubuntu_22.04.4_LTS_GPU) to its default login user.
+// Images are matched case-insensitively, and entries can be added for custom images.
+var ImageUsers = map[string]string{
+	"ubuntu": "ubuntu",
+	"debian": "debian",
+	"rocky":  "rocky",
+	"centos": "centos",
+}
+
+// ImageUser returns the default login user of an OS image, or "" if the image is unknown.
+// The longest matching prefix wins, so custom images can override the defaults.
+func ImageUser(image string) string {
+	image = strings.ToLower(image)
+	user, longest := "", -1
+	for prefix, u := range ImageUsers {
+		if strings.HasPrefix(image, strings.ToLower(prefix)) && len(prefix) > longest {
+			user, longest = u, len(prefix)
+		}
+	}
+	return user
+}
+
+// ServerTarget returns the target for a virtual server, with the default login user of its OS image (see ImageUser).
+// NOTE: VirtualServerDetailsItem.Username is the user who created the server rather than a login user,
+// so User is empty for unknown images and must be provided (e.g., in the ClientConfig passed to Dial).
+func ServerTarget(vm virtual.VirtualServerDetailsItem) (Target, error) {
+	t := Target{
+		Kind:        "server",
+		Name:        vm.GetId(),
+		Cluster:     vm.GetCluster(),
+		Host:        vm.GetIp(),
+		PrivateHost: vm.GetPrivateIp(),
+		User:        ImageUser(vm.GetImage()),
+	}
+	return t, t.validate()
+}
+
+// ApplicationTarget returns the target for an application, preferring the public IP over the DNS name.
+func ApplicationTarget(app applications.ApplicationsApiOverview) (Target, error) {
+	t := Target{
+		Kind:        "application",
+		Name:        app.GetId(),
+		Cluster:     app.GetCluster(),
+		Host:        app.GetPublicIp(),
+		PrivateHost: app.GetPrivateIp(),
+		User:        app.GetSshUsername(),
+	}
+	if t.Host == "" {
+		t.Host = app.GetDns()
+	}
+	return t, t.validate()
+}
+
+func (t Target) validate() error {
+	if t.Host == "" && t.PrivateHost == "" {
+		return fmt.Errorf("%s %s/%s has no address yet", t.Kind, t.Cluster, t.Name)
+	}
+	return nil
+}
+
+// ResolveServer looks up a virtual server and returns its target.
+// Servers without a public IP are resolved to their private IP.
+func ResolveServer(ctx context.Context, client virtual.ClientInterface, params virtual.GetServerParams) (Target, error) {
+	vm, err := client.GetServer(ctx, &params)
+	if err != nil {
+		return Target{}, err
+	}
+	t, err := ServerTarget(*vm)
+	t.Private = t.Host == ""
+	return t, err
+}
+
+// ResolveApplication looks up an application and returns its target.
+// NOTE: Only the application overview includes the SSH username, so we search the list of applications.
+func ResolveApplication(ctx context.Context, client applications.ClientInterface, cluster, name string) (Target, error) {
+	rsp, err := client.GetApplications(ctx)
+	if err != nil {
+		return Target{}, err
+	}
+	for _, app := range rsp.GetItems() {
+		if app.GetId() == name && app.GetCluster() == cluster {
+			t, err := ApplicationTarget(app)
+			t.Private = t.Host == ""
+			return t, err
+		}
+	}
+	return Target{}, fmt.Errorf("application %s/%s not found", cluster, name)
+}
+
+// Fleet returns a target for every server and application with an address. Either client may be nil.
+func Fleet(ctx context.Context, vc virtual.ClientInterface, ac applications.ClientInterface) ([]Target, error) {
+	var targets []Target
+	if vc != nil {
+		rsp, err := vc.GetServers(ctx, &virtual.GetServersParams{})
+		if err != nil {
+			return nil, err
+		}
+		for _, vm := range rsp.GetItems() {
+			if t, err := ServerTarget(vm); err == nil {
+				targets = append(targets, t)
+			}
+		}
+	}
+	if ac != nil {
+		rsp, err := ac.GetApplications(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, app := range rsp.GetItems() {
+			if t, err := ApplicationTarget(app); err == nil {
+				targets = append(targets, t)
+			}
+		}
+	}
+	return targets, nil
+}
+
+// WaitForPort polls until the target accepts TCP connections (e.g., while the server is still booting).
+// The Options default to polling every 10 seconds until the context is cancelled.
+func WaitForPort(ctx context.Context, t Target, opts waiter.Options) error {
+	return waiter.Poll(
+		ctx,
+		opts,
+		func(ctx context.Context) (bool, error) {
+			dialer := net.Dialer{Timeout: 5 * time.Second}
+			conn, err := dialer.DialContext(ctx, "tcp", t.Addr())
+			if err != nil {
+				return false, nil
+			}
+			conn.Close()
+			return true, nil
+		},
+	)
+}
+
+// Dial waits for the target's SSH port and connects. If config.User is empty, the target user is used,
+// and one of them is required. A nil config is treated as an empty one.
+func Dial(ctx context.Context, t Target, config *cryptossh.ClientConfig, opts waiter.Options) (*cryptossh.Client, error) {
+	var conf cryptossh.ClientConfig
+	if config != nil {
+		conf = *config
+	}
+	if conf.User == "" {
+		conf.User = t.User
+	}
+	if conf.User == "" {
+		return nil, fmt.Errorf("%s %s/%s has no known login user, set ClientConfig.User", t.Kind, t.Cluster, t.Name)
+	}
+
+	if err := WaitForPort(ctx, t, opts); err != nil {
+		return nil, fmt.Errorf("waiting for %s: %w", t.Addr(), err)
+	}
+
+	dialer := net.Dialer{Timeout: conf.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", t.Addr())
+	if err != nil {
+		return nil, err
+	}
+	// The handshake isn't context aware, so we close the connection if the context is cancelled first.
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	c, chans, reqs, err := cryptossh.NewClientConn(conn, t.Addr(), &conf)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return cryptossh.NewClient(c, chans, reqs), nil
+}
+
+// Run executes cmd in a new session, streaming its output to stdout and stderr (either may be nil).
+func Run(client *cryptossh.Client, cmd string, stdout, stderr io.Writer) error {
+	session, err := client.NewSession()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	session.Stdout = stdout
+	session.Stderr = stderr
+	return session.Run(cmd)
+}
+
+// Output executes cmd in a new session and returns its combined stdout and stderr.
+func Output(client *cryptossh.Client, cmd string) ([]byte, error) {
+	session, err := client.NewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer session.Close()
+	return session.CombinedOutput(cmd)
+}
+
+// Shell opens an interactive login shell with a pseudo terminal of the given size,
+// returning once the remote shell exits.
+func Shell(client *cryptossh.Client, term string, width, height int, stdin io.Reader, stdout, stderr io.Writer) error {
+	session, err := client.NewSession()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	modes := cryptossh.TerminalModes{cryptossh.ECHO: 1}
+	if err := session.RequestPty(term, height, width, modes); err != nil {
+		return err
+	}
+	session.Stdin, session.Stdout, session.Stderr = stdin, stdout, stderr
+	if err := session.Shell(); err != nil {
+		return err
+	}
+	err = session.Wait()
+	// A shell exiting with a non-zero status isn't a connection failure.
+	var exit *cryptossh.ExitError
+	if errors.As(err, &exit) {
+		return nil
+	}
+	return err
+}
+
+// ConfigOptions controls the generated ~/.ssh/config entries.
+type ConfigOptions struct {
+	// Prefix is prepended to each Host alias (defaults to "denvr-")
+	Prefix string
+	// IdentityFile is added to every entry if set
+	IdentityFile string
+	// Private uses the private address of every target
+	Private bool
+	// ProxyJump is added to every entry if set (e.g., a bastion host for private addresses)
+	ProxyJump string
+	// User is the login user of targets without one (e.g., servers with custom images)
+	User string
+}
+
+// Config renders ~/.ssh/config entries for the targets, with aliases of the form <prefix><cluster>-<name>.
+// Targets without the requested address are skipped.
+func Config(targets []Target, opts ConfigOptions) string {
+	prefix := opts.Prefix
+	if prefix == "" {
+		prefix = "denvr-"
+	}
+
+	var b strings.Builder
+	for _, t := range targets {
+		host := t.Host
+		if opts.Private {
+			host = t.PrivateHost
+		}
+		if host == "" {
+			continue
+		}
+
+		fmt.Fprintf(&b, "Host %s%s-%s\n", prefix, strings.ToLower(t.Cluster), t.Name)
+		fmt.Fprintf(&b, "    HostName %s\n", host)
+		user := t.User
+		if user == "" {
+			user = opts.User
+		}
+		if user != "" {
+			fmt.Fprintf(&b, "    User %s\n", user)
+		}
+		if t.Port != 0 && t.Port != DefaultPort {
+			fmt.Fprintf(&b, "    Port %d\n", t.Port)
+		}
+		if opts.IdentityFile != "" {
+			fmt.Fprintf(&b, "    IdentityFile %s\n", opts.IdentityFile)
+		}
+		if opts.ProxyJump != "" {
+			fmt.Fprintf(&b, "    ProxyJump %s\n", opts.ProxyJump)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,211 @@
+package ssh_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/denvrtest"
+	"github.com/denvrdata/go-denvr/ssh"
+	"github.com/denvrdata/go-denvr/waiter"
+	"github.com/stretchr/testify/assert"
+	cryptossh "golang.org/x/crypto/ssh"
+)
+
+// sshServer starts a minimal SSH server which accepts any password for "ubuntu"
+// and responds to every exec request with the command it was given.
+func sshServer(t *testing.T) (string, int) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	signer, err := cryptossh.NewSignerFromKey(key)
+	assert.NoError(t, err)
+
+	config := &cryptossh.ServerConfig{
+		PasswordCallback: func(conn cryptossh.ConnMetadata, password []byte) (*cryptossh.Permissions, error) {
+			if conn.User() != "ubuntu" {
+				return nil, fmt.Errorf("unknown user %s", conn.User())
+			}
+			return nil, nil
+		},
+	}
+	config.AddHostKey(signer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_, chans, reqs, err := cryptossh.NewServerConn(conn, config)
+				if err != nil {
+					return
+				}
+				go cryptossh.DiscardRequests(reqs)
+				for ch := range chans {
+					channel, requests, _ := ch.Accept()
+					go func() {
+						defer channel.Close()
+						for req := range requests {
+							if req.Type != "exec" {
+								req.Reply(false, nil)
+								continue
+							}
+							req.Reply(true, nil)
+							// The payload is a length prefixed string
+							cmd := req.Payload[4 : 4+binary.BigEndian.Uint32(req.Payload)]
+							fmt.Fprintf(channel, "ran %s", cmd)
+							channel.SendRequest("exit-status", false, []byte{0, 0, 0, 0})
+							return
+						}
+					}()
+				}
+			}()
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	p, _ := strconv.Atoi(port)
+	return host, p
+}
+
+func TestDial(t *testing.T) {
+	host, port := sshServer(t)
+	target := ssh.Target{Kind: "server", Name: "vm", Cluster: "Msc1", PrivateHost: host, User: "ubuntu", Port: port, Private: true}
+	config := &cryptossh.ClientConfig{
+		Auth:            []cryptossh.AuthMethod{cryptossh.Password("secret")},
+		HostKeyCallback: cryptossh.InsecureIgnoreHostKey(),
+	}
+
+	client, err := ssh.Dial(context.TODO(), target, config, waiter.Options{Interval: time.Millisecond, Timeout: time.Second})
+	assert.NoError(t, err)
+	defer client.Close()
+
+	out, err := ssh.Output(client, "nvidia-smi")
+	assert.NoError(t, err)
+	assert.Equal(t, "ran nvidia-smi", string(out))
+
+	// A nil config is dialed as an empty one, which crypto/ssh rejects without a HostKeyCallback
+	_, err = ssh.Dial(context.TODO(), target, nil, waiter.Options{Interval: time.Millisecond, Timeout: time.Second})
+	assert.ErrorContains(t, err, "must specify HostKeyCallback")
+
+	// Nothing is listening on a port we just released
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	target.Port = closed.Addr().(*net.TCPAddr).Port
+	closed.Close()
+	err = ssh.WaitForPort(context.TODO(), target, waiter.Options{Interval: time.Millisecond, Timeout: 20 * time.Millisecond})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestImageUser(t *testing.T) {
+	assert.Equal(t, "ubuntu", ssh.ImageUser("Ubuntu_22.04.4_LTS_GPU"))
+	assert.Equal(t, "rocky", ssh.ImageUser("rocky-9"))
+	assert.Equal(t, "", ssh.ImageUser("my-image"))
+	assert.Equal(t, "", ssh.ImageUser(""))
+
+	ssh.ImageUsers["ubuntu-dl"] = "jovyan"
+	t.Cleanup(func() { delete(ssh.ImageUsers, "ubuntu-dl") })
+	assert.Equal(t, "jovyan", ssh.ImageUser("Ubuntu-DL-22.04"))
+
+	// Targets without a login user can't be dialed without one in the ClientConfig
+	_, err := ssh.Dial(
+		context.TODO(),
+		ssh.Target{Kind: "server", Name: "custom", Cluster: "Msc1", Host: "203.0.113.6"},
+		&cryptossh.ClientConfig{},
+		waiter.Options{},
+	)
+	assert.EqualError(t, err, "server Msc1/custom has no known login user, set ClientConfig.User")
+	_, err = ssh.Dial(
+		context.TODO(),
+		ssh.Target{Kind: "server", Name: "custom", Cluster: "Msc1", Host: "203.0.113.6"},
+		nil,
+		waiter.Options{},
+	)
+	assert.EqualError(t, err, "server Msc1/custom has no known login user, set ClientConfig.User")
+}
+
+func TestResolve(t *testing.T) {
+	server := denvrtest.NewServer(t)
+	server.Reply("/api/v1/servers/virtual/GetServer", http.StatusOK, `{"id": "vm", "cluster": "Msc1", "ip": "", "privateIp": "10.0.0.5", "image": "Ubuntu_22.04.4_LTS_GPU", "username": "alice@denvrdata.com"}`)
+	server.Reply(
+		"/api/v1/servers/virtual/GetServers",
+		http.StatusOK,
+		`{
+			"items": [
+				{"id": "vm", "cluster": "Msc1", "ip": "203.0.113.5", "privateIp": "10.0.0.5", "image": "Ubuntu_22.04.4_LTS", "username": "alice@denvrdata.com"},
+				{"id": "custom", "cluster": "Msc1", "ip": "203.0.113.6", "image": "my-image", "username": "alice@denvrdata.com"},
+				{"id": "pending", "cluster": "Msc1"}
+			]
+		}`,
+	)
+	server.Reply(
+		"/api/v1/servers/applications/GetApplications",
+		http.StatusOK,
+		`{
+			"items": [
+				{"id": "notebook", "cluster": "Hou1", "dns": "notebook.hou1.denvr.cloud", "privateIp": "10.1.0.7", "sshUsername": "jovyan"}
+			]
+		}`,
+	)
+
+	vc := server.Virtual()
+	ac := server.Applications()
+
+	target, err := ssh.ResolveServer(context.TODO(), vc, virtual.GetServerParams{Id: "vm", Namespace: "denvr", Cluster: "Msc1"})
+	assert.NoError(t, err)
+	assert.True(t, target.Private)
+	assert.Equal(t, "10.0.0.5:22", target.Addr())
+	// The login user comes from the image rather than the owner
+	assert.Equal(t, "ubuntu", target.User)
+
+	target, err = ssh.ResolveApplication(context.TODO(), ac, "Hou1", "notebook")
+	assert.NoError(t, err)
+	assert.Equal(t, "notebook.hou1.denvr.cloud:22", target.Addr())
+	assert.Equal(t, "jovyan", target.User)
+
+	_, err = ssh.ResolveApplication(context.TODO(), ac, "Msc1", "notebook")
+	assert.EqualError(t, err, "application Msc1/notebook not found")
+
+	targets, err := ssh.Fleet(context.TODO(), vc, ac)
+	assert.NoError(t, err)
+	assert.Len(t, targets, 3)
+	assert.Equal(
+		t,
+		`Host denvr-msc1-vm
+    HostName 203.0.113.5
+    User ubuntu
+    IdentityFile ~/.ssh/denvr_ed25519
+
+Host denvr-msc1-custom
+    HostName 203.0.113.6
+    User admin
+    IdentityFile ~/.ssh/denvr_ed25519
+
+Host denvr-hou1-notebook
+    HostName notebook.hou1.denvr.cloud
+    User jovyan
+    IdentityFile ~/.ssh/denvr_ed25519
+
+`,
+		ssh.Config(targets, ssh.ConfigOptions{IdentityFile: "~/.ssh/denvr_ed25519", User: "admin"}),
+	)
+	assert.Equal(
+		t,
+		"Host dev-msc1-vm\n    HostName 10.0.0.5\n    User ubuntu\n    ProxyJump bastion\n\n"+
+			"Host dev-hou1-notebook\n    HostName 10.1.0.7\n    User jovyan\n    ProxyJump bastion\n\n",
+		ssh.Config(targets, ssh.ConfigOptions{Prefix: "dev-", Private: true, ProxyJump: "bastion"}),
+	)
+}
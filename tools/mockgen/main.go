@@ -0,0 +1,470 @@
+// Command mockgen generates a programmable fake of a service's ClientInterface.
+//
+// It's run by go generate after oapi-codegen, so the mocks are regenerated with the client:
+//
+//	//go:generate go run github.com/denvrdata/go-denvr/tools/mockgen -source virtual.gen.go -output mock/mock.gen.go
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+func main() {
+	source := flag.String("source", "", "generated client file declaring ClientInterface")
+	output := flag.String("output", "mock/mock.gen.go", "file to write the mock to")
+	iface := flag.String("interface", "ClientInterface", "name of the interface to mock")
+	flag.Parse()
+
+	if *source == "" {
+		log.Fatal("mockgen: -source is required")
+	}
+	importPath, err := importPath(filepath.Dir(*source))
+	if err != nil {
+		log.Fatalf("mockgen: %v", err)
+	}
+	src, err := os.ReadFile(*source)
+	if err != nil {
+		log.Fatalf("mockgen: %v", err)
+	}
+	out, err := Generate(src, importPath, *iface, path.Base(filepath.ToSlash(filepath.Dir(*output))))
+	if err != nil {
+		log.Fatalf("mockgen: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(*output), 0o755); err != nil {
+		log.Fatalf("mockgen: %v", err)
+	}
+	if err := os.WriteFile(*output, out, 0o644); err != nil {
+		log.Fatalf("mockgen: %v", err)
+	}
+}
+
+// importPath resolves the import path of dir from the nearest go.mod.
+func importPath(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for root := dir; ; root = filepath.Dir(root) {
+		data, err := os.ReadFile(filepath.Join(root, "go.mod"))
+		if err == nil {
+			for _, line := range strings.Split(string(data), "\n") {
+				if module, ok := strings.CutPrefix(strings.TrimSpace(line), "module "); ok {
+					rel, err := filepath.Rel(root, dir)
+					if err != nil {
+						return "", err
+					}
+					return path.Join(strings.Trim(strings.TrimSpace(module), `"`), filepath.ToSlash(rel)), nil
+				}
+			}
+			return "", fmt.Errorf("no module directive in %s", filepath.Join(root, "go.mod"))
+		}
+		if filepath.Dir(root) == root {
+			return "", errors.New("go.mod not found")
+		}
+	}
+}
+
+// Param is a method parameter of the mocked interface.
+type Param struct {
+	Name     string
+	Type     string
+	Variadic bool
+}
+
+// Method is a method of the mocked interface.
+type Method struct {
+	Name   string
+	Params []Param
+	// Result is the type of the first result, which is always followed by an error
+	Result string
+}
+
+// Signature renders the parameter list with the given names.
+func (m Method) Signature() string {
+	params := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		if p.Variadic {
+			params[i] = fmt.Sprintf("%s ...%s", p.Name, p.Type)
+		} else {
+			params[i] = fmt.Sprintf("%s %s", p.Name, p.Type)
+		}
+	}
+	return strings.Join(params, ", ")
+}
+
+// Forward renders the arguments which pass the parameters through to another call.
+func (m Method) Forward() string {
+	args := make([]string, len(m.Params))
+	for i, p := range m.Params {
+		args[i] = p.Name
+		if p.Variadic {
+			args[i] += "..."
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+// Recorded renders the arguments which are recorded and matched, skipping the context and request editors.
+func (m Method) Recorded() string {
+	var args []string
+	for _, p := range m.Params {
+		if p.Type != "context.Context" && !p.Variadic {
+			args = append(args, p.Name)
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+// Generate renders the mock of the named interface declared in src.
+func Generate(src []byte, importPath, iface, pkg string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, 0)
+	if err != nil {
+		return nil, err
+	}
+	service := file.Name.Name
+
+	var spec *ast.InterfaceType
+	ast.Inspect(file, func(n ast.Node) bool {
+		if ts, ok := n.(*ast.TypeSpec); ok && ts.Name.Name == iface {
+			spec, _ = ts.Type.(*ast.InterfaceType)
+		}
+		return spec == nil
+	})
+	if spec == nil {
+		return nil, fmt.Errorf("interface %s not found in package %s", iface, service)
+	}
+
+	imports := map[string]string{service: importPath}
+	known := map[string]string{}
+	for _, imp := range file.Imports {
+		p, _ := strconv.Unquote(imp.Path.Value)
+		name := path.Base(p)
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		known[name] = p
+	}
+
+	var methods []Method
+	for _, field := range spec.Methods.List {
+		fn, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) == 0 {
+			return nil, fmt.Errorf("%s embeds another interface, which isn't supported", iface)
+		}
+		if fn.Results == nil || len(fn.Results.List) != 2 {
+			return nil, fmt.Errorf("%s.%s must return a result and an error", iface, field.Names[0].Name)
+		}
+
+		m := Method{Name: field.Names[0].Name}
+		for i, p := range fn.Params.List {
+			typ := p.Type
+			variadic := false
+			if e, ok := typ.(*ast.Ellipsis); ok {
+				typ, variadic = e.Elt, true
+			}
+			rendered, err := qualify(typ, service, known, imports)
+			if err != nil {
+				return nil, err
+			}
+			names := p.Names
+			if len(names) == 0 {
+				names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("arg%d", i))}
+			}
+			for _, name := range names {
+				m.Params = append(m.Params, Param{Name: name.Name, Type: rendered, Variadic: variadic})
+			}
+		}
+		if m.Result, err = qualify(fn.Results.List[0].Type, service, known, imports); err != nil {
+			return nil, err
+		}
+		methods = append(methods, m)
+	}
+
+	// Standard library imports are grouped before the others, as goimports would.
+	std := []string{"errors", "fmt", "reflect", "slices", "sync"}
+	var others []string
+	for _, p := range imports {
+		if strings.Contains(strings.Split(p, "/")[0], ".") {
+			others = append(others, p)
+		} else if !slices.Contains(std, p) {
+			std = append(std, p)
+		}
+	}
+	slices.Sort(std)
+	slices.Sort(others)
+
+	var buf bytes.Buffer
+	err = mockTemplate.Execute(&buf, map[string]any{
+		"Package":   pkg,
+		"Service":   service,
+		"Interface": iface,
+		"Std":       std,
+		"Imports":   others,
+		"Methods":   methods,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting mock: %w\n%s", err, buf.String())
+	}
+	return out, nil
+}
+
+// qualify renders a type expression from the service package as seen from the mock package,
+// recording the imports it needs.
+func qualify(expr ast.Expr, service string, known, imports map[string]string) (string, error) {
+	var err error
+	expr = rewrite(expr, func(e ast.Expr) ast.Expr {
+		switch e := e.(type) {
+		case *ast.SelectorExpr:
+			if x, ok := e.X.(*ast.Ident); ok {
+				p, ok := known[x.Name]
+				if !ok {
+					err = fmt.Errorf("unknown package %s", x.Name)
+				}
+				imports[x.Name] = p
+			}
+			return e
+		case *ast.Ident:
+			if e.IsExported() {
+				return &ast.SelectorExpr{X: ast.NewIdent(service), Sel: e}
+			}
+		}
+		return e
+	})
+	if err != nil {
+		return "", err
+	}
+	return types.ExprString(expr), nil
+}
+
+// rewrite applies f to the leaves of the type expressions used in client signatures.
+func rewrite(expr ast.Expr, f func(ast.Expr) ast.Expr) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: rewrite(e.X, f)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: e.Len, Elt: rewrite(e.Elt, f)}
+	case *ast.MapType:
+		return &ast.MapType{Key: rewrite(e.Key, f), Value: rewrite(e.Value, f)}
+	default:
+		return f(e)
+	}
+}
+
+var mockTemplate = template.Must(template.New("mock").Parse(`// Package {{.Package}} provides a programmable fake of {{.Service}}.{{.Interface}} for tests.
+//
+// Code generated by github.com/denvrdata/go-denvr/tools/mockgen DO NOT EDIT.
+package {{.Package}}
+
+import (
+{{- range .Std}}
+	"{{.}}"
+{{- end}}
+{{range .Imports}}
+	"{{.}}"
+{{- end}}
+)
+
+// ErrNotStubbed is returned by calls without a stub func or a matching stub.
+var ErrNotStubbed = errors.New("mock: call not stubbed")
+
+// Call is a recorded call. Args excludes the context and request editors.
+type Call struct {
+	Method string
+	Args   []any
+}
+
+// Matches reports whether the arguments of the call satisfy the matchers, in order.
+// Arguments without a matcher are ignored.
+func (c Call) Matches(matchers ...Matcher) bool {
+	if len(matchers) > len(c.Args) {
+		return false
+	}
+	for i, m := range matchers {
+		if !m.Match(c.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Matcher matches a call argument.
+type Matcher interface {
+	Match(arg any) bool
+}
+
+// MatcherFunc adapts a function to a Matcher.
+type MatcherFunc func(arg any) bool
+
+func (f MatcherFunc) Match(arg any) bool {
+	return f(arg)
+}
+
+// Any matches every argument.
+func Any() Matcher {
+	return MatcherFunc(func(any) bool { return true })
+}
+
+// Eq matches arguments deeply equal to v. Pointers are compared by the values they point to.
+func Eq(v any) Matcher {
+	want := indirect(v)
+	return MatcherFunc(func(arg any) bool { return reflect.DeepEqual(indirect(arg), want) })
+}
+
+// Func matches arguments of type T for which f returns true.
+func Func[T any](f func(T) bool) Matcher {
+	return MatcherFunc(func(arg any) bool {
+		v, ok := arg.(T)
+		return ok && f(v)
+	})
+}
+
+func indirect(v any) any {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return nil
+	}
+	return rv.Interface()
+}
+
+// Stub returns a canned response from calls with matching arguments.
+type Stub[T any] struct {
+	matchers []Matcher
+	rsp      T
+	err      error
+	// times is the number of calls the stub answers, unlimited if zero
+	times int
+	used  int
+}
+
+// Return sets the response and error returned by the stub.
+func (s *Stub[T]) Return(rsp T, err error) *Stub[T] {
+	s.rsp, s.err = rsp, err
+	return s
+}
+
+// Times limits the number of calls answered by the stub, after which later stubs are considered.
+func (s *Stub[T]) Times(n int) *Stub[T] {
+	s.times = n
+	return s
+}
+
+func answer[T any](stubs []*Stub[T], args []any) (*Stub[T], bool) {
+	for _, s := range stubs {
+		if (s.times == 0 || s.used < s.times) && (Call{Args: args}).Matches(s.matchers...) {
+			s.used++
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+// Client is a programmable {{.Service}}.{{.Interface}}.
+//
+// Each call is recorded, then answered by its stub func if set, otherwise by the first
+// matching stub registered with the corresponding On method, otherwise with ErrNotStubbed.
+type Client struct {
+{{- range .Methods}}
+	{{.Name}}Func func({{.Signature}}) ({{.Result}}, error)
+{{- end}}
+
+	mu    sync.Mutex
+	calls []Call
+{{- range .Methods}}
+	stubs{{.Name}} []*Stub[{{.Result}}]
+{{- end}}
+}
+
+var _ {{.Service}}.{{.Interface}} = (*Client)(nil)
+
+// Calls returns the recorded calls to the method, or every call if method is empty.
+func (c *Client) Calls(method string) []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if method == "" {
+		return slices.Clone(c.calls)
+	}
+	var calls []Call
+	for _, call := range c.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Called reports whether the method was called with arguments satisfying the matchers.
+func (c *Client) Called(method string, matchers ...Matcher) bool {
+	for _, call := range c.Calls(method) {
+		if call.Matches(matchers...) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reset clears the recorded calls and registered stubs. Stub funcs are kept.
+func (c *Client) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = nil
+{{- range .Methods}}
+	c.stubs{{.Name}} = nil
+{{- end}}
+}
+
+func (c *Client) record(method string, args ...any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls = append(c.calls, Call{Method: method, Args: args})
+}
+{{range .Methods}}
+// On{{.Name}} registers a stub for {{.Name}} calls with arguments satisfying the matchers.
+func (c *Client) On{{.Name}}(matchers ...Matcher) *Stub[{{.Result}}] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := &Stub[{{.Result}}]{matchers: matchers}
+	c.stubs{{.Name}} = append(c.stubs{{.Name}}, s)
+	return s
+}
+
+// {{.Name}} records the call and returns the stubbed response.
+func (c *Client) {{.Name}}({{.Signature}}) ({{.Result}}, error) {
+	args := []any{ {{- .Recorded -}} }
+	c.record("{{.Name}}", args...)
+	if c.{{.Name}}Func != nil {
+		return c.{{.Name}}Func({{.Forward}})
+	}
+	c.mu.Lock()
+	s, ok := answer(c.stubs{{.Name}}, args)
+	c.mu.Unlock()
+	if !ok {
+		var zero {{.Result}}
+		return zero, fmt.Errorf("%w: {{.Name}}", ErrNotStubbed)
+	}
+	return s.rsp, s.err
+}
+{{end}}`))
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerated guards against the committed mocks drifting from the generated clients.
+func TestGenerated(t *testing.T) {
+	for _, service := range []string{"virtual", "applications"} {
+		t.Run(service, func(t *testing.T) {
+			dir := filepath.Join("..", "..", "api", "v1", "servers", service)
+			src, err := os.ReadFile(filepath.Join(dir, service+".gen.go"))
+			require.NoError(t, err)
+			want, err := os.ReadFile(filepath.Join(dir, "mock", "mock.gen.go"))
+			require.NoError(t, err)
+
+			path, err := importPath(dir)
+			require.NoError(t, err)
+			assert.Equal(t, "github.com/denvrdata/go-denvr/api/v1/servers/"+service, path)
+
+			got, err := Generate(src, path, "ClientInterface", "mock")
+			require.NoError(t, err)
+			assert.Equal(t, string(want), string(got), "run go generate ./api/... to update the mocks")
+		})
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	_, err := Generate([]byte("package foo\n\ntype Other interface{}\n"), "example.com/foo", "ClientInterface", "mock")
+	assert.ErrorContains(t, err, "interface ClientInterface not found in package foo")
+
+	_, err = Generate(
+		[]byte("package foo\n\ntype ClientInterface interface {\n\tGet() error\n}\n"),
+		"example.com/foo",
+		"ClientInterface",
+		"mock",
+	)
+	assert.ErrorContains(t, err, "ClientInterface.Get must return a result and an error")
+}
@@ -0,0 +1,271 @@
+// Command opsgen generates the registry of operations in api/v1/registry from the filtered spec.
+//
+// Tags are mapped to the generated packages by the include-tags of each cfg.yaml next to the spec,
+// so operations reference the same Go types as the clients:
+//
+//	//go:generate go run github.com/denvrdata/go-denvr/tools/opsgen -spec ../api.json -output registry.gen.go
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oapi-codegen/oapi-codegen/v2/pkg/codegen"
+	"gopkg.in/yaml.v3"
+)
+
+func main() {
+	spec := flag.String("spec", "api.json", "filtered OpenAPI spec")
+	output := flag.String("output", "registry.gen.go", "file to write the registry to")
+	pkg := flag.String("package", "registry", "package of the output file")
+	flag.Parse()
+
+	doc, err := openapi3.NewLoader().LoadFromFile(*spec)
+	if err != nil {
+		log.Fatalf("opsgen: %v", err)
+	}
+	services, err := Services(filepath.Dir(*spec))
+	if err != nil {
+		log.Fatalf("opsgen: %v", err)
+	}
+	out, err := Generate(doc, services, *pkg)
+	if err != nil {
+		log.Fatalf("opsgen: %v", err)
+	}
+	if err := os.WriteFile(*output, out, 0o644); err != nil {
+		log.Fatalf("opsgen: %v", err)
+	}
+}
+
+// Service is a generated package and the tags it includes.
+type Service struct {
+	Package    string
+	ImportPath string
+	Tags       []string
+}
+
+// Services reads the cfg.yaml of every generated client package below dir.
+func Services(dir string) ([]Service, error) {
+	var services []Service
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || d.Name() != "cfg.yaml" {
+			return err
+		}
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		var cfg struct {
+			Package  string `yaml:"package"`
+			Generate struct {
+				Client bool `yaml:"client"`
+			} `yaml:"generate"`
+			OutputOptions struct {
+				IncludeTags []string `yaml:"include-tags"`
+			} `yaml:"output-options"`
+		}
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return fmt.Errorf("%s: %w", p, err)
+		}
+		// Packages with only models (e.g., api/v1/models) have no operations to register
+		if !cfg.Generate.Client {
+			return nil
+		}
+		importPath, err := importPath(filepath.Dir(p))
+		if err != nil {
+			return err
+		}
+		services = append(services, Service{Package: cfg.Package, ImportPath: importPath, Tags: cfg.OutputOptions.IncludeTags})
+		return nil
+	})
+	return services, err
+}
+
+// importPath resolves the import path of dir from the nearest go.mod.
+func importPath(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for root := dir; ; root = filepath.Dir(root) {
+		data, err := os.ReadFile(filepath.Join(root, "go.mod"))
+		if err == nil {
+			for _, line := range strings.Split(string(data), "\n") {
+				if module, ok := strings.CutPrefix(strings.TrimSpace(line), "module "); ok {
+					rel, err := filepath.Rel(root, dir)
+					if err != nil {
+						return "", err
+					}
+					return path.Join(strings.Trim(strings.TrimSpace(module), `"`), filepath.ToSlash(rel)), nil
+				}
+			}
+			return "", fmt.Errorf("no module directive in %s", filepath.Join(root, "go.mod"))
+		}
+		if filepath.Dir(root) == root {
+			return "", errors.New("go.mod not found")
+		}
+	}
+}
+
+// Operation is a registry entry, with the Go types already rendered.
+type Operation struct {
+	ID, Method, Path, Tag, Package string
+	Params                         []*openapi3.Parameter
+	ParamsType, Request, Response  string
+	Deprecated                     bool
+}
+
+// Generate renders the registry of the operations in doc, sorted by tag, path and method.
+func Generate(doc *openapi3.T, services []Service, pkg string) ([]byte, error) {
+	byTag := map[string]Service{}
+	for _, s := range services {
+		for _, tag := range s.Tags {
+			byTag[tag] = s
+		}
+	}
+
+	var ops []Operation
+	imports := map[string]bool{}
+	for p, item := range doc.Paths.Map() {
+		for method, op := range item.Operations() {
+			if len(op.Tags) == 0 {
+				return nil, fmt.Errorf("%s %s has no tags", method, p)
+			}
+			o := Operation{
+				ID:         op.OperationID,
+				Method:     method,
+				Path:       p,
+				Tag:        op.Tags[0],
+				Deprecated: op.Deprecated,
+			}
+			for _, param := range op.Parameters {
+				o.Params = append(o.Params, param.Value)
+			}
+
+			if s, ok := byTag[o.Tag]; ok {
+				imports[s.ImportPath] = true
+				o.Package = s.Package
+				// Mirror how oapi-codegen names the generated types
+				name := codegen.ToCamelCase(op.OperationID)
+				if len(op.Parameters) > 0 {
+					o.ParamsType = typeFor(s.Package, name+"Params")
+				}
+				if op.RequestBody != nil && op.RequestBody.Value.Content.Get("application/json") != nil {
+					o.Request = typeFor(s.Package, name+"JSONRequestBody")
+				}
+				if ref := responseRef(op); ref != "" {
+					o.Response = typeFor(s.Package, codegen.SchemaNameToTypeName(ref))
+				}
+			}
+			ops = append(ops, o)
+		}
+	}
+	slices.SortFunc(ops, func(a, b Operation) int {
+		return strings.Compare(a.Tag+" "+a.Path+" "+a.Method, b.Tag+" "+b.Path+" "+b.Method)
+	})
+
+	var paths []string
+	for p := range imports {
+		paths = append(paths, p)
+	}
+	slices.Sort(paths)
+
+	var buf bytes.Buffer
+	if err := registryTemplate.Execute(&buf, map[string]any{"Package": pkg, "Imports": paths, "Operations": ops}); err != nil {
+		return nil, err
+	}
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting registry: %w\n%s", err, buf.String())
+	}
+	return out, nil
+}
+
+// responseRef returns the schema name of the successful JSON response, if it's a reference.
+func responseRef(op *openapi3.Operation) string {
+	for _, status := range []int{200, 201, 202} {
+		rsp := op.Responses.Status(status)
+		if rsp == nil || rsp.Value == nil {
+			continue
+		}
+		if media := rsp.Value.Content.Get("application/json"); media != nil && media.Schema != nil && media.Schema.Ref != "" {
+			return path.Base(media.Schema.Ref)
+		}
+	}
+	return ""
+}
+
+func typeFor(pkg, name string) string {
+	return fmt.Sprintf("reflect.TypeFor[%s.%s]()", pkg, name)
+}
+
+var registryTemplate = template.Must(template.New("registry").Funcs(template.FuncMap{
+	"schemaType": func(p *openapi3.Parameter) string {
+		if p.Schema == nil || p.Schema.Value == nil || p.Schema.Value.Type == nil {
+			return ""
+		}
+		return strings.Join(p.Schema.Value.Type.Slice(), ",")
+	},
+	"schemaFormat": func(p *openapi3.Parameter) string {
+		if p.Schema == nil || p.Schema.Value == nil {
+			return ""
+		}
+		return p.Schema.Value.Format
+	},
+}).Parse(`// Code generated by github.com/denvrdata/go-denvr/tools/opsgen DO NOT EDIT.
+
+package {{.Package}}
+
+{{- if .Imports}}
+import (
+	"reflect"
+{{range .Imports}}
+	"{{.}}"
+{{- end}}
+)
+{{- end}}
+
+// Operations lists every operation in the embedded spec, sorted by tag, path and method.
+var Operations = []Operation{
+{{- range .Operations}}
+	{
+		ID:      {{printf "%q" .ID}},
+		Method:  {{printf "%q" .Method}},
+		Path:    {{printf "%q" .Path}},
+		Tag:     {{printf "%q" .Tag}},
+		Package: {{printf "%q" .Package}},
+		{{- if .Params}}
+		Params: []Param{
+			{{- range .Params}}
+			{Name: {{printf "%q" .Name}}, In: {{printf "%q" .In}}, Required: {{.Required}}, Type: {{printf "%q" (schemaType .)}}, Format: {{printf "%q" (schemaFormat .)}}, Description: {{printf "%q" .Description}}},
+			{{- end}}
+		},
+		{{- end}}
+		{{- if .ParamsType}}
+		ParamsType: {{.ParamsType}},
+		{{- end}}
+		{{- if .Request}}
+		Request: {{.Request}},
+		{{- end}}
+		{{- if .Response}}
+		Response: {{.Response}},
+		{{- end}}
+		{{- if .Deprecated}}
+		Deprecated: true,
+		{{- end}}
+	},
+{{- end}}
+}
+`))
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerated guards against the committed registry drifting from the spec.
+func TestGenerated(t *testing.T) {
+	dir := filepath.Join("..", "..", "api", "v1")
+	doc, err := openapi3.NewLoader().LoadFromFile(filepath.Join(dir, "api.json"))
+	require.NoError(t, err)
+	want, err := os.ReadFile(filepath.Join(dir, "registry", "registry.gen.go"))
+	require.NoError(t, err)
+
+	services, err := Services(dir)
+	require.NoError(t, err)
+	assert.ElementsMatch(
+		t,
+		[]Service{
+			{Package: "applications", ImportPath: "github.com/denvrdata/go-denvr/api/v1/servers/applications", Tags: []string{"servers/applications"}},
+			{Package: "virtual", ImportPath: "github.com/denvrdata/go-denvr/api/v1/servers/virtual", Tags: []string{"servers/virtual"}},
+		},
+		services,
+	)
+
+	got, err := Generate(doc, services, "registry")
+	require.NoError(t, err)
+	assert.Equal(t, string(want), string(got), "run go generate ./api/... to update the registry")
+}
+
+func TestUngeneratedTags(t *testing.T) {
+	doc, err := openapi3.NewLoader().LoadFromData([]byte(`{
+		"openapi": "3.0.1",
+		"info": {"title": "test", "version": "v1"},
+		"paths": {
+			"/api/v1/clusters/GetAll": {
+				"get": {"tags": ["clusters"], "operationId": "GetAll", "responses": {"200": {"description": "OK"}}}
+			}
+		}
+	}`))
+	require.NoError(t, err)
+
+	out, err := Generate(doc, nil, "registry")
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `Path:    "/api/v1/clusters/GetAll",`)
+	assert.Contains(t, string(out), `Package: "",`)
+	assert.NotContains(t, string(out), "reflect")
+}
@@ -0,0 +1,316 @@
+// Command specdiff compares the previous and new filtered spec and writes a Markdown report of the changes,
+// so reviewers of the nightly regeneration don't have to read the generated Go diffs.
+//
+// Changes which break existing SDK callers (e.g., removed operations or fields, type changes and newly required params)
+// are flagged as breaking:
+//
+//	go run ./tools/specdiff -old previous.json -new api/v1/api.json -output report.md
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+func main() {
+	oldSpec := flag.String("old", "", "previous filtered OpenAPI spec")
+	newSpec := flag.String("new", "api/v1/api.json", "new filtered OpenAPI spec")
+	output := flag.String("output", "", "file to write the report to (defaults to stdout)")
+	flag.Parse()
+
+	if *oldSpec == "" {
+		log.Fatal("specdiff: -old is required")
+	}
+	before, err := openapi3.NewLoader().LoadFromFile(*oldSpec)
+	if err != nil {
+		log.Fatalf("specdiff: %v", err)
+	}
+	after, err := openapi3.NewLoader().LoadFromFile(*newSpec)
+	if err != nil {
+		log.Fatalf("specdiff: %v", err)
+	}
+
+	report := Report(Diff(before, after))
+	if *output == "" {
+		fmt.Print(report)
+		return
+	}
+	if err := os.WriteFile(*output, []byte(report), 0o644); err != nil {
+		log.Fatalf("specdiff: %v", err)
+	}
+}
+
+// Kind classifies a Change.
+type Kind string
+
+// Parameters are reported as fields of their operation (e.g., GET /api/v1/servers/virtual/GetServer query.Id).
+const (
+	AddedOperation   Kind = "Added operation"
+	RemovedOperation Kind = "Removed operation"
+	AddedSchema      Kind = "Added schema"
+	RemovedSchema    Kind = "Removed schema"
+	AddedField       Kind = "Added field"
+	RemovedField     Kind = "Removed field"
+	TypeChange       Kind = "Type change"
+	NewlyRequired    Kind = "Newly required"
+)
+
+// Change is a single difference between two specs.
+type Change struct {
+	Kind Kind
+	// Location is the operation (e.g., GET /api/v1/servers/virtual/GetServers), parameter or schema field
+	Location string
+	Detail   string
+	// Breaking is set for changes which require updates to existing SDK callers
+	Breaking bool
+}
+
+// Diff compares the operations, parameters and schemas of two specs, including the fields of inline objects
+// and array items (e.g., Server.tags[].name). Changes are sorted by location, with breaking changes first.
+func Diff(before, after *openapi3.T) []Change {
+	var changes []Change
+	oldOps, newOps := operations(before), operations(after)
+	for key, op := range newOps {
+		if _, ok := oldOps[key]; !ok {
+			changes = append(changes, Change{Kind: AddedOperation, Location: key, Detail: op.OperationID})
+		}
+	}
+	for key, oldOp := range oldOps {
+		newOp, ok := newOps[key]
+		if !ok {
+			changes = append(changes, Change{Kind: RemovedOperation, Location: key, Detail: oldOp.OperationID, Breaking: true})
+			continue
+		}
+		changes = append(changes, diffOperation(key, oldOp, newOp)...)
+	}
+
+	oldSchemas, newSchemas := schemas(before), schemas(after)
+	for name, newSchema := range newSchemas {
+		if oldSchema, ok := oldSchemas[name]; ok {
+			changes = append(changes, diffSchema(name, oldSchema, newSchema)...)
+		} else {
+			changes = append(changes, Change{Kind: AddedSchema, Location: name, Detail: typeOf(newSchema)})
+		}
+	}
+	// The generated type is removed along with the schema
+	for name, oldSchema := range oldSchemas {
+		if _, ok := newSchemas[name]; !ok {
+			changes = append(changes, Change{Kind: RemovedSchema, Location: name, Detail: typeOf(oldSchema), Breaking: true})
+		}
+	}
+
+	slices.SortFunc(changes, func(a, b Change) int {
+		if a.Breaking != b.Breaking {
+			if a.Breaking {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Location+" "+string(a.Kind), b.Location+" "+string(b.Kind))
+	})
+	return changes
+}
+
+func operations(doc *openapi3.T) map[string]*openapi3.Operation {
+	ops := map[string]*openapi3.Operation{}
+	if doc.Paths == nil {
+		return ops
+	}
+	for p, item := range doc.Paths.Map() {
+		for method, op := range item.Operations() {
+			ops[method+" "+p] = op
+		}
+	}
+	return ops
+}
+
+func schemas(doc *openapi3.T) openapi3.Schemas {
+	if doc.Components == nil {
+		return nil
+	}
+	return doc.Components.Schemas
+}
+
+func diffOperation(key string, before, after *openapi3.Operation) []Change {
+	var changes []Change
+	oldParams, newParams := params(before), params(after)
+	for name, p := range newParams {
+		location := key + " " + name
+		old, ok := oldParams[name]
+		switch {
+		case !ok && p.Required:
+			changes = append(changes, Change{Kind: NewlyRequired, Location: location, Detail: "new required " + typeOf(p.Schema), Breaking: true})
+		case !ok:
+			changes = append(changes, Change{Kind: AddedField, Location: location, Detail: typeOf(p.Schema)})
+		case p.Required && !old.Required:
+			changes = append(changes, Change{Kind: NewlyRequired, Location: location, Detail: typeOf(p.Schema), Breaking: true})
+		}
+		if ok && typeOf(old.Schema) != typeOf(p.Schema) {
+			changes = append(changes, typeChange(location, old.Schema, p.Schema))
+		}
+	}
+	for name, p := range oldParams {
+		if _, ok := newParams[name]; !ok {
+			changes = append(changes, Change{Kind: RemovedField, Location: key + " " + name, Detail: typeOf(p.Schema), Breaking: true})
+		}
+	}
+
+	if before, after := requestBody(before), requestBody(after); before != nil && after != nil {
+		changes = append(changes, diffType(key+" request body", before, after)...)
+	}
+	if before, after := response(before), response(after); before != nil && after != nil {
+		changes = append(changes, diffType(key+" response", before, after)...)
+	}
+	return changes
+}
+
+// diffType reports a type change, or the changes within inline schemas of the same type.
+// Referenced schemas are compared once as components rather than wherever they're used.
+func diffType(location string, before, after *openapi3.SchemaRef) []Change {
+	if typeOf(before) != typeOf(after) {
+		return []Change{typeChange(location, before, after)}
+	}
+	if before.Ref != "" || after.Ref != "" || before.Value == nil || after.Value == nil {
+		return nil
+	}
+	if before.Value.Type.Is("array") {
+		return diffType(location+"[]", before.Value.Items, after.Value.Items)
+	}
+	return diffSchema(location, before, after)
+}
+
+// params indexes the parameters of op by location and name (e.g., query.Id).
+func params(op *openapi3.Operation) map[string]*openapi3.Parameter {
+	params := map[string]*openapi3.Parameter{}
+	for _, p := range op.Parameters {
+		if p.Value != nil {
+			params[p.Value.In+"."+p.Value.Name] = p.Value
+		}
+	}
+	return params
+}
+
+func requestBody(op *openapi3.Operation) *openapi3.SchemaRef {
+	if op.RequestBody == nil || op.RequestBody.Value == nil {
+		return nil
+	}
+	if media := op.RequestBody.Value.Content.Get("application/json"); media != nil {
+		return media.Schema
+	}
+	return nil
+}
+
+func response(op *openapi3.Operation) *openapi3.SchemaRef {
+	if op.Responses == nil {
+		return nil
+	}
+	for _, status := range []int{200, 201, 202} {
+		rsp := op.Responses.Status(status)
+		if rsp == nil || rsp.Value == nil {
+			continue
+		}
+		if media := rsp.Value.Content.Get("application/json"); media != nil {
+			return media.Schema
+		}
+	}
+	return nil
+}
+
+func diffSchema(name string, before, after *openapi3.SchemaRef) []Change {
+	if before.Value == nil || after.Value == nil {
+		return nil
+	}
+	var changes []Change
+	for field, schema := range after.Value.Properties {
+		location := name + "." + field
+		old, ok := before.Value.Properties[field]
+		required := slices.Contains(after.Value.Required, field)
+		switch {
+		case !ok && required:
+			changes = append(changes, Change{Kind: NewlyRequired, Location: location, Detail: "new required " + typeOf(schema), Breaking: true})
+		case !ok:
+			changes = append(changes, Change{Kind: AddedField, Location: location, Detail: typeOf(schema)})
+		case required && !slices.Contains(before.Value.Required, field):
+			changes = append(changes, Change{Kind: NewlyRequired, Location: location, Detail: typeOf(schema), Breaking: true})
+		}
+		if ok {
+			changes = append(changes, diffType(location, old, schema)...)
+		}
+	}
+	for field, schema := range before.Value.Properties {
+		if _, ok := after.Value.Properties[field]; !ok {
+			changes = append(changes, Change{Kind: RemovedField, Location: name + "." + field, Detail: typeOf(schema), Breaking: true})
+		}
+	}
+	return changes
+}
+
+// typeChange is always breaking, as the generated field changes type (e.g., a pointer becoming a value).
+func typeChange(location string, before, after *openapi3.SchemaRef) Change {
+	return Change{Kind: TypeChange, Location: location, Detail: typeOf(before) + " → " + typeOf(after), Breaking: true}
+}
+
+// typeOf summarizes a schema as the referenced schema name or its type and format (e.g., `string (date-time)`).
+func typeOf(s *openapi3.SchemaRef) string {
+	if s == nil {
+		return "any"
+	}
+	if s.Ref != "" {
+		return path.Base(s.Ref)
+	}
+	if s.Value == nil {
+		return "any"
+	}
+
+	var t string
+	switch {
+	case s.Value.Type == nil || len(s.Value.Type.Slice()) == 0:
+		t = "any"
+	case s.Value.Type.Is("array"):
+		t = "[]" + typeOf(s.Value.Items)
+	default:
+		t = strings.Join(s.Value.Type.Slice(), ",")
+	}
+	if s.Value.Format != "" {
+		t += " (" + s.Value.Format + ")"
+	}
+	if s.Value.Nullable {
+		t += ", nullable"
+	}
+	return t
+}
+
+// Report renders the changes as Markdown for the body of the nightly pull request.
+func Report(changes []Change) string {
+	var b strings.Builder
+	b.WriteString("## API changes\n\n")
+	if len(changes) == 0 {
+		b.WriteString("No changes to the included paths or their schemas.\n")
+		return b.String()
+	}
+
+	breaking := slices.IndexFunc(changes, func(c Change) bool { return !c.Breaking })
+	if breaking < 0 {
+		breaking = len(changes)
+	}
+	if breaking > 0 {
+		fmt.Fprintf(&b, "> [!WARNING]\n> %d breaking change(s) for existing SDK callers.\n\n", breaking)
+	}
+
+	b.WriteString("| | Change | Location | Details |\n|---|---|---|---|\n")
+	for _, c := range changes {
+		flag := ""
+		if c.Breaking {
+			flag = ":warning:"
+		}
+		fmt.Fprintf(&b, "| %s | %s | `%s` | %s |\n", flag, c.Kind, c.Location, strings.ReplaceAll(c.Detail, "|", `\|`))
+	}
+	return b.String()
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const before = `{
+	"openapi": "3.0.1",
+	"info": {"title": "test", "version": "v1"},
+	"paths": {
+		"/api/v1/servers/virtual/GetServer": {
+			"get": {
+				"operationId": "GetServer",
+				"parameters": [
+					{"name": "Id", "in": "query", "schema": {"type": "string"}},
+					{"name": "Cluster", "in": "query", "schema": {"type": "string"}}
+				],
+				"responses": {"200": {"description": "OK", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Server"}}}}}
+			}
+		},
+		"/api/v1/servers/virtual/StopServer": {
+			"post": {"operationId": "StopServer", "responses": {"200": {"description": "OK"}}}
+		}
+	},
+	"components": {
+		"schemas": {
+			"Server": {
+				"type": "object",
+				"properties": {
+					"id": {"type": "string", "nullable": true},
+					"gpus": {"type": "integer", "format": "int32"},
+					"tenancy": {"type": "string"},
+					"owner": {"type": "object", "properties": {"name": {"type": "string"}, "email": {"type": "string"}}},
+					"disks": {"type": "array", "items": {"type": "object", "properties": {"size": {"type": "integer"}}}}
+				}
+			},
+			"Legacy": {"type": "object", "properties": {"id": {"type": "string"}}}
+		}
+	}
+}`
+
+const after = `{
+	"openapi": "3.0.1",
+	"info": {"title": "test", "version": "v1"},
+	"paths": {
+		"/api/v1/servers/virtual/GetServer": {
+			"get": {
+				"operationId": "GetServer",
+				"parameters": [
+					{"name": "Id", "in": "query", "required": true, "schema": {"type": "string"}},
+					{"name": "Cluster", "in": "query", "schema": {"type": "string"}},
+					{"name": "Verbose", "in": "query", "schema": {"type": "boolean"}}
+				],
+				"responses": {"200": {"description": "OK", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Server"}}}}}
+			}
+		},
+		"/api/v1/servers/virtual/StartServer": {
+			"post": {"operationId": "StartServer", "responses": {"200": {"description": "OK"}}}
+		}
+	},
+	"components": {
+		"schemas": {
+			"Server": {
+				"type": "object",
+				"required": ["region"],
+				"properties": {
+					"id": {"type": "string", "nullable": true},
+					"gpus": {"type": "string"},
+					"region": {"type": "string"},
+					"tags": {"type": "array", "items": {"type": "string"}},
+					"owner": {"type": "object", "properties": {"name": {"type": "string"}}},
+					"disks": {"type": "array", "items": {"type": "object", "properties": {"size": {"type": "string"}}}}
+				}
+			},
+			"Region": {"type": "object", "properties": {"name": {"type": "string"}}}
+		}
+	}
+}`
+
+func load(t *testing.T, data string) *openapi3.T {
+	t.Helper()
+	doc, err := openapi3.NewLoader().LoadFromData([]byte(data))
+	require.NoError(t, err)
+	return doc
+}
+
+func TestDiff(t *testing.T) {
+	changes := Diff(load(t, before), load(t, after))
+	assert.Equal(
+		t,
+		[]Change{
+			{Kind: NewlyRequired, Location: "GET /api/v1/servers/virtual/GetServer query.Id", Detail: "string", Breaking: true},
+			{Kind: RemovedSchema, Location: "Legacy", Detail: "object", Breaking: true},
+			{Kind: RemovedOperation, Location: "POST /api/v1/servers/virtual/StopServer", Detail: "StopServer", Breaking: true},
+			{Kind: TypeChange, Location: "Server.disks[].size", Detail: "integer → string", Breaking: true},
+			{Kind: TypeChange, Location: "Server.gpus", Detail: "integer (int32) → string", Breaking: true},
+			{Kind: RemovedField, Location: "Server.owner.email", Detail: "string", Breaking: true},
+			{Kind: NewlyRequired, Location: "Server.region", Detail: "new required string", Breaking: true},
+			{Kind: RemovedField, Location: "Server.tenancy", Detail: "string", Breaking: true},
+			{Kind: AddedField, Location: "GET /api/v1/servers/virtual/GetServer query.Verbose", Detail: "boolean"},
+			{Kind: AddedOperation, Location: "POST /api/v1/servers/virtual/StartServer", Detail: "StartServer"},
+			{Kind: AddedSchema, Location: "Region", Detail: "object"},
+			{Kind: AddedField, Location: "Server.tags", Detail: "[]string"},
+		},
+		changes,
+	)
+}
+
+func TestReport(t *testing.T) {
+	report := Report(Diff(load(t, before), load(t, after)))
+	assert.Contains(t, report, "> 8 breaking change(s) for existing SDK callers.")
+	assert.Contains(t, report, "| :warning: | Type change | `Server.gpus` | integer (int32) → string |\n")
+	assert.Contains(t, report, "|  | Added field | `Server.tags` | []string |\n")
+
+	assert.Equal(t, "## API changes\n\nNo changes to the included paths or their schemas.\n", Report(nil))
+}
+
+func TestUnchanged(t *testing.T) {
+	path := filepath.Join("..", "..", "api", "v1", "api.json")
+	doc, err := openapi3.NewLoader().LoadFromFile(path)
+	require.NoError(t, err)
+	assert.Empty(t, Diff(doc, doc))
+}
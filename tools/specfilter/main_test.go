@@ -0,0 +1,395 @@
+package main
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const spec = `{
+	"openapi": "3.0.1",
+	"info": {"title": "test", "version": "v1"},
+	"paths": {
+		"/api/v1/servers/virtual/GetServer": {
+			"get": {
+				"operationId": "ApiV1ServersVirtualGetServerGet",
+				"responses": {"200": {"description": "OK", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Server"}}}}}
+			}
+		},
+		"/api/v1/servers/metal/GetHosts": {
+			"get": {"operationId": "ApiV1ServersMetalGetHostsGet", "responses": {"200": {"description": "OK", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Host"}}}}}}
+		}
+	},
+	"components": {
+		"schemas": {
+			"Server": {
+				"type": "object",
+				"properties": {
+					"lastUpdated": {"type": "string", "format": "date-time"},
+					"gpus": {"type": "integer", "format": "int32"},
+					"owner": {"$ref": "#/components/schemas/Owner"}
+				}
+			},
+			"Owner": {"type": "object", "properties": {"tags": {"type": "array", "items": {"$ref": "#/components/schemas/Tag"}}}},
+			"Tag": {"type": "string"},
+			"Host": {"type": "object"}
+		}
+	}
+}`
+
+func TestFilter(t *testing.T) {
+	m := &Manifest{
+		DropFormats: []string{"date-time"},
+		Services: []Service{
+			{Package: "virtual", Tags: []string{"servers/virtual"}, Paths: []string{"/api/v1/servers/virtual/GetServer", "/api/v1/servers/virtual/GetServers"}},
+		},
+	}
+	out, missing, err := Filter([]byte(spec), m)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/api/v1/servers/virtual/GetServers"}, missing)
+
+	var filtered struct {
+		Paths      map[string]map[string]map[string]any
+		Components struct {
+			Schemas map[string]map[string]any
+		}
+	}
+	require.NoError(t, json.Unmarshal(out, &filtered))
+	assert.Len(t, filtered.Paths, 1)
+	assert.Equal(t, "GetServer", filtered.Paths["/api/v1/servers/virtual/GetServer"]["get"]["operationId"])
+
+	// Host is only referenced by the excluded path
+	schemas := filtered.Components.Schemas
+	assert.ElementsMatch(t, []string{"Server", "Owner", "Tag"}, keys(schemas))
+	properties := schemas["Server"]["properties"].(map[string]any)
+	assert.Equal(t, map[string]any{"type": "string"}, properties["lastUpdated"])
+	assert.Equal(t, map[string]any{"type": "integer", "format": "int32"}, properties["gpus"])
+}
+
+func TestFilterMultipleOperations(t *testing.T) {
+	m := &Manifest{Services: []Service{{Paths: []string{"/api/v1/servers/virtual/GetServer"}}}}
+	_, _, err := Filter([]byte(`{"paths": {"/api/v1/servers/virtual/GetServer": {"get": {}, "post": {}}}}`), m)
+	assert.ErrorContains(t, err, "/api/v1/servers/virtual/GetServer has 2 operations, expected 1")
+}
+
+// TestManifest guards against api.json drifting from the manifest, as filtering it again should be a no-op.
+func TestManifest(t *testing.T) {
+	dir := filepath.Join("..", "..", "api", "v1")
+	m, err := LoadManifest(filepath.Join(dir, "services.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "api.json", m.Output)
+
+	want, err := os.ReadFile(filepath.Join(dir, m.Output))
+	require.NoError(t, err)
+	got, _, err := Filter(want, m)
+	require.NoError(t, err)
+	assert.Equal(t, string(want), string(got), "run go run ./tools/specfilter -manifest api/v1/services.yaml")
+}
+
+func TestFetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/swagger.json" {
+			http.NotFound(w, r)
+			return
+		}
+		assert.Equal(t, "Mozilla", r.UserAgent())
+		w.Write([]byte(spec))
+	}))
+	defer server.Close()
+
+	data, err := Fetch(server.URL + "/swagger.json")
+	require.NoError(t, err)
+	assert.Equal(t, spec, string(data))
+
+	_, err = Fetch(server.URL + "/missing.json")
+	assert.ErrorContains(t, err, "404 Not Found")
+
+	path := filepath.Join(t.TempDir(), "swagger.json")
+	require.NoError(t, os.WriteFile(path, []byte(spec), 0o644))
+	data, err = Fetch(path)
+	require.NoError(t, err)
+	assert.Equal(t, spec, string(data))
+}
+
+// TestScaffold checks new services get the same files as the existing ones.
+func TestScaffold(t *testing.T) {
+	dir := filepath.Join("..", "..", "api", "v1")
+	m, err := LoadManifest(filepath.Join(dir, "services.yaml"))
+	require.NoError(t, err)
+
+	// A service directory at the same depth, so the relative paths match
+	tmp, err := os.MkdirTemp(filepath.Join("..", "..", "api"), "scaffold")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(tmp) })
+
+	for _, s := range m.Services {
+		if s.Package == "" {
+			continue
+		}
+		target := filepath.Join(tmp, s.Directory())
+		require.NoError(t, Scaffold(target, s, filepath.Join(tmp, "api.json")))
+		for _, name := range []string{"cfg.yaml", "generate.go"} {
+			want, err := os.ReadFile(filepath.Join(dir, s.Directory(), name))
+			require.NoError(t, err)
+			got, err := os.ReadFile(filepath.Join(target, name))
+			require.NoError(t, err)
+			assert.Equal(t, string(want), string(got), name)
+		}
+	}
+
+	// Existing files are left alone
+	cfg := filepath.Join(tmp, "servers", "virtual", "cfg.yaml")
+	require.NoError(t, os.WriteFile(cfg, []byte("custom"), 0o644))
+	require.NoError(t, Scaffold(filepath.Dir(cfg), Service{Package: "virtual", Tags: []string{"servers/virtual"}}, filepath.Join(tmp, "api.json")))
+	data, err := os.ReadFile(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, "custom", string(data))
+}
+
+// TestGenerate checks the tools don't contain go:generate directives by accident (e.g., in the generate.go template),
+// as the nightly build runs go generate on the whole module.
+func TestGenerate(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go isn't installed")
+	}
+	cmd := exec.Command("go", "generate", "./tools/...")
+	cmd.Dir = filepath.Join("..", "..")
+	out, err := cmd.CombinedOutput()
+	assert.NoError(t, err, string(out))
+}
+
+func TestModels(t *testing.T) {
+	m := &Manifest{
+		Models: Models{
+			Import:     "github.com/denvrdata/go-denvr/api/v1/models",
+			ListResult: "ListResult",
+			Schemas:    map[string]string{"Server": "Server"},
+			Fields:     map[string]string{"Server.lastUpdated": "Time"},
+		},
+		Services: []Service{{Paths: []string{"/api/v1/servers/virtual/GetServers"}}},
+	}
+	data := `{
+		"paths": {
+			"/api/v1/servers/virtual/GetServers": {
+				"get": {"responses": {"200": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/ListResultDtoOfServer"}}}}}}
+			}
+		},
+		"components": {
+			"schemas": {
+				"ListResultDtoOfServer": {"type": "object", "properties": {"items": {"type": "array", "items": {"$ref": "#/components/schemas/Server"}}}},
+				"Server": {"type": "object", "properties": {"id": {"type": "string"}, "lastUpdated": {"type": "string"}, "owner": {"$ref": "#/components/schemas/ListResultDtoOfOwner"}}},
+				"ListResultDtoOfOwner": {"type": "object", "properties": {"items": {"type": "array", "items": {"type": "string"}}}}
+			}
+		}
+	}`
+	out, _, err := Filter([]byte(data), m)
+	require.NoError(t, err)
+
+	var filtered struct {
+		Components struct {
+			Schemas map[string]map[string]any
+		}
+	}
+	require.NoError(t, json.Unmarshal(out, &filtered))
+	schemas := filtered.Components.Schemas
+	assert.Equal(t, "models.ListResult[Server]", schemas["ListResultDtoOfServer"]["x-go-type"])
+	assert.Equal(t, map[string]any{"path": "github.com/denvrdata/go-denvr/api/v1/models"}, schemas["ListResultDtoOfServer"]["x-go-type-import"])
+	assert.Equal(t, "models.Server", schemas["Server"]["x-go-type"])
+	// The properties are kept for validation
+	properties := schemas["Server"]["properties"].(map[string]any)
+	assert.Equal(t, "models.Time", properties["lastUpdated"].(map[string]any)["x-go-type"])
+	// Not a list of schemas
+	assert.NotContains(t, schemas["ListResultDtoOfOwner"], "x-go-type")
+
+	m.Models.Fields = map[string]string{"Server.created": "Time"}
+	_, _, err = Filter([]byte(data), m)
+	assert.EqualError(t, err, "field Server.created isn't in the filtered spec")
+}
+
+func TestModelsSpec(t *testing.T) {
+	m := Models{
+		Import:  "github.com/denvrdata/go-denvr/api/v1/models",
+		Schemas: map[string]string{"ServerAvailability": "Availability", "AppAvailability": "Availability"},
+		Params:  map[string][]string{"AvailabilityParams": {"/virtual/GetAvailability", "/applications/GetAvailability"}},
+	}
+	data := `{
+		"openapi": "3.0.1",
+		"info": {"title": "test", "version": "v1"},
+		"paths": {
+			"/virtual/GetAvailability": {
+				"get": {"parameters": [
+					{"name": "cluster", "in": "query", "required": true, "schema": {"type": "string"}},
+					{"name": "resourcePool", "in": "query", "schema": {"type": "string"}}
+				]}
+			},
+			"/applications/GetAvailability": {
+				"get": {"parameters": [
+					{"name": "cluster", "in": "query", "required": true, "schema": {"type": "string"}},
+					{"name": "resourcePool", "in": "query", "required": true, "schema": {"type": "string"}},
+					{"name": "reportNodes", "in": "query", "description": "Include the node names", "schema": {"type": "boolean"}}
+				]}
+			}
+		},
+		"components": {
+			"schemas": {
+				"ServerAvailability": {
+					"type": "object",
+					"x-go-type": "models.Availability",
+					"required": ["cluster"],
+					"properties": {"cluster": {"type": "string"}, "count": {"type": "integer", "nullable": true}, "rpool": {"type": "string", "nullable": true}}
+				},
+				"AppAvailability": {
+					"type": "object",
+					"required": ["cluster"],
+					"properties": {"cluster": {"type": "string", "description": "Cluster name"}, "rpool": {"type": "string", "nullable": true}, "price": {"type": "number"}}
+				}
+			}
+		}
+	}`
+	out, err := m.Spec([]byte(data))
+	require.NoError(t, err)
+
+	var spec struct {
+		Paths      map[string]any
+		Components struct {
+			Schemas map[string]map[string]any
+		}
+	}
+	require.NoError(t, json.Unmarshal(out, &spec))
+	assert.Empty(t, spec.Paths)
+
+	availability := spec.Components.Schemas["Availability"]
+	assert.NotContains(t, availability, "x-go-type")
+	assert.Equal(t, []any{"cluster"}, availability["required"])
+	properties := availability["properties"].(map[string]any)
+	assert.ElementsMatch(t, []string{"cluster", "count", "rpool", "price"}, slices.Collect(maps.Keys(properties)))
+	assert.Equal(t, "Cluster name", properties["cluster"].(map[string]any)["description"])
+	assert.Equal(t, true, properties["rpool"].(map[string]any)["nullable"])
+
+	params := spec.Components.Schemas["AvailabilityParams"]
+	assert.Equal(t, []any{"cluster"}, params["required"], "only required by both operations")
+	properties = params["properties"].(map[string]any)
+	assert.Equal(t, "Include the node names", properties["reportNodes"].(map[string]any)["description"])
+	assert.Contains(t, properties, "resourcePool")
+
+	// Shared schemas must have the same Go type
+	conflict := strings.Replace(data, `"price": {"type": "number"}`, `"count": {"type": "string"}`, 1)
+	_, err = m.Spec([]byte(conflict))
+	assert.EqualError(t, err, "shared schema Availability: property count has conflicting definitions")
+
+	// Only query parameters fit in a params struct
+	header := strings.Replace(data, `"name": "reportNodes", "in": "query"`, `"name": "reportNodes", "in": "header"`, 1)
+	_, err = m.Spec([]byte(header))
+	assert.EqualError(
+		t,
+		err,
+		"params AvailabilityParams: /applications/GetAvailability parameter reportNodes is in header, only query parameters can be shared",
+	)
+}
+
+func TestIterators(t *testing.T) {
+	m := &Manifest{
+		Services: []Service{{Paths: []string{"/api/v1/servers/virtual/GetServers", "/api/v1/servers/virtual/GetOwners"}}},
+	}
+	data := `{
+		"paths": {
+			"/api/v1/servers/virtual/GetServers": {
+				"get": {"responses": {"200": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/ListResultDtoOfServer"}}}}}}
+			},
+			"/api/v1/servers/virtual/GetOwners": {
+				"get": {"responses": {"200": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/ListResultDtoOfOwner"}}}}}}
+			}
+		},
+		"components": {
+			"schemas": {
+				"ListResultDtoOfServer": {"type": "object", "properties": {"items": {"type": "array", "items": {"$ref": "#/components/schemas/Server"}}}},
+				"Server": {"type": "object", "properties": {"id": {"type": "string"}}},
+				"ListResultDtoOfOwner": {"type": "object", "properties": {"items": {"type": "array", "items": {"type": "string"}}}}
+			}
+		}
+	}`
+	out, _, err := Filter([]byte(data), m)
+	require.NoError(t, err)
+
+	var filtered struct {
+		Paths map[string]map[string]map[string]any
+	}
+	require.NoError(t, json.Unmarshal(out, &filtered))
+	assert.Equal(
+		t,
+		map[string]any{"name": "AllServers", "item": "Server"},
+		filtered.Paths["/api/v1/servers/virtual/GetServers"]["get"]["x-go-iterator"],
+	)
+	// Not a list of schemas
+	assert.NotContains(t, filtered.Paths["/api/v1/servers/virtual/GetOwners"]["get"], "x-go-iterator")
+}
+
+func TestOptional(t *testing.T) {
+	m := &Manifest{
+		Optional: []string{"Server.gpus", "Server.tags", "Server.price"},
+		Services: []Service{{Paths: []string{"/api/v1/servers/virtual/GetServer"}}},
+	}
+	data := `{
+		"paths": {
+			"/api/v1/servers/virtual/GetServer": {
+				"get": {"responses": {"200": {"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Server"}}}}}}
+			}
+		},
+		"components": {
+			"schemas": {
+				"Server": {
+					"type": "object",
+					"properties": {
+						"gpus": {"type": "integer", "format": "int32", "nullable": true},
+						"tags": {"type": "array", "items": {"type": "string"}},
+						"price": {"type": "number", "format": "double"}
+					}
+				}
+			}
+		}
+	}`
+	out, _, err := Filter([]byte(data), m)
+	require.NoError(t, err)
+
+	var filtered struct {
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]map[string]any
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal(out, &filtered))
+	properties := filtered.Components.Schemas["Server"].Properties
+	assert.Equal(t, "result.Optional[int32]", properties["gpus"]["x-go-type"])
+	assert.Equal(t, "result.Optional[[]string]", properties["tags"]["x-go-type"])
+	assert.Equal(t, "result.Optional[float64]", properties["price"]["x-go-type"])
+	assert.Equal(t, true, properties["gpus"]["x-omitempty"])
+	assert.NotContains(t, properties["gpus"], "x-go-type-skip-optional-pointer")
+	assert.Equal(t, map[string]any{"path": "github.com/denvrdata/go-denvr/result"}, properties["gpus"]["x-go-type-import"])
+
+	m.Optional = []string{"Server.owner"}
+	_, _, err = Filter([]byte(data), m)
+	assert.EqualError(t, err, "optional field Server.owner isn't in the filtered spec")
+
+	m.Optional = []string{"Server.gpus"}
+	_, _, err = Filter([]byte(strings.Replace(data, `"type": "object",`, `"type": "object", "required": ["gpus"],`, 1)), m)
+	assert.EqualError(t, err, "optional field Server.gpus is required, so it can't be absent")
+}
+
+func keys(m map[string]map[string]any) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -0,0 +1,251 @@
+// Package validator checks request and response bodies against the embedded OpenAPI spec,
+// so drift between the API and the generated clients is caught early (e.g., a field changing type).
+//
+// Validation is off by default. It can be enabled with `validate = "log"` or `validate = "fail"`
+// in the [defaults] section of the config file, or the DENVR_VALIDATE environment variable.
+// The config only supports the setting once this package is imported, so clients which don't validate
+// don't link the embedded spec and kin-openapi:
+//
+//	import _ "github.com/denvrdata/go-denvr/validator"
+//
+// Usage:
+//
+//	client, err := validator.Wrap(conf.Client, validator.Fail)
+//	vc := virtual.Client{Server: conf.Server, Client: client, RequestEditors: []virtual.RequestEditorFn{conf.Auth.Intercept}}
+package validator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	v1 "github.com/denvrdata/go-denvr/api/v1"
+	"github.com/denvrdata/go-denvr/config"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+)
+
+func init() {
+	config.RegisterValidator(
+		func(client *http.Client, mode string) (*http.Client, error) {
+			m, err := ParseMode(mode)
+			if err != nil {
+				return nil, err
+			}
+			return Wrap(client, m)
+		},
+	)
+}
+
+// Mode determines what happens when a body doesn't match the spec.
+type Mode string
+
+const (
+	// Off disables validation
+	Off Mode = ""
+	// Log reports mismatches with OnMismatch (the standard logger by default) and carries on
+	Log Mode = "log"
+	// Fail returns mismatches as errors. Invalid requests aren't sent.
+	Fail Mode = "fail"
+)
+
+// ParseMode parses "log", "fail" or an empty string (Off).
+func ParseMode(s string) (Mode, error) {
+	switch mode := Mode(strings.ToLower(strings.TrimSpace(s))); mode {
+	case Off, Log, Fail:
+		return mode, nil
+	default:
+		return Off, fmt.Errorf("invalid validation mode %q, expected log or fail", s)
+	}
+}
+
+// Mismatch describes a request or response which doesn't match the spec.
+type Mismatch struct {
+	Operation string
+	Method    string
+	Path      string
+	// Response is false for request mismatches
+	Response bool
+	Status   int
+	Err      error
+}
+
+func (m *Mismatch) Error() string {
+	if m.Response {
+		return fmt.Sprintf("%s response (%d) from %s %s doesn't match the spec: %v", m.Operation, m.Status, m.Method, m.Path, m.Err)
+	}
+	return fmt.Sprintf("%s request to %s %s doesn't match the spec: %v", m.Operation, m.Method, m.Path, m.Err)
+}
+
+func (m *Mismatch) Unwrap() error {
+	return m.Err
+}
+
+// Validator is an http.RoundTripper which validates the bodies of operations in the spec.
+// Requests to other paths (e.g., authentication) and non-2xx responses are passed through unchecked.
+type Validator struct {
+	// Next sends the requests (defaults to http.DefaultTransport)
+	Next http.RoundTripper
+	Mode Mode
+	// OnMismatch is called for every mismatch in Log mode
+	OnMismatch func(*Mismatch)
+
+	routes map[string]*routers.Route
+}
+
+// New creates a validator using the embedded spec.
+func New(next http.RoundTripper, mode Mode) (*Validator, error) {
+	doc, err := v1.Load()
+	if err != nil {
+		return nil, err
+	}
+	return NewWithSpec(doc, next, mode), nil
+}
+
+// NewWithSpec creates a validator for another spec.
+func NewWithSpec(doc *openapi3.T, next http.RoundTripper, mode Mode) *Validator {
+	// NOTE: We don't use the kin-openapi routers, as they require the whole spec to be valid
+	// and our operationIds are only unique within a service.
+	routes := map[string]*routers.Route{}
+	for path, item := range doc.Paths.Map() {
+		for method, op := range item.Operations() {
+			routes[method+" "+path] = &routers.Route{
+				Spec:      doc,
+				Path:      path,
+				PathItem:  item,
+				Method:    method,
+				Operation: op,
+			}
+		}
+	}
+	return &Validator{Next: next, Mode: mode, routes: routes}
+}
+
+// Wrap returns a copy of client which validates through its transport. Off returns the client as is.
+func Wrap(client *http.Client, mode Mode) (*http.Client, error) {
+	if mode == Off {
+		return client, nil
+	}
+	v, err := New(client.Transport, mode)
+	if err != nil {
+		return nil, err
+	}
+	wrapped := *client
+	wrapped.Transport = v
+	return &wrapped, nil
+}
+
+// RoundTrip validates the request, sends it and validates the response.
+func (v *Validator) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := v.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	route := v.route(req)
+	if route == nil || v.Mode == Off {
+		return next.RoundTrip(req)
+	}
+
+	// The body is read by the validation, so we buffer it to send it afterwards
+	body, err := read(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	rewind := func() {
+		if body != nil {
+			req.Body = io.NopCloser(bytes.NewReader(body))
+		}
+	}
+	rewind()
+
+	input := &openapi3filter.RequestValidationInput{
+		Request: req,
+		Route:   route,
+		Options: &openapi3filter.Options{
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+			MultiError:         true,
+		},
+	}
+	if err := openapi3filter.ValidateRequest(req.Context(), input); err != nil {
+		if err := v.report(&Mismatch{Operation: route.Operation.OperationID, Method: req.Method, Path: route.Path, Err: err}); err != nil {
+			return nil, err
+		}
+	}
+	rewind()
+
+	rsp, err := next.RoundTrip(req)
+	if err != nil || rsp.StatusCode < 200 || rsp.StatusCode > 299 {
+		return rsp, err
+	}
+	rspBody, err := read(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = io.NopCloser(bytes.NewReader(rspBody))
+
+	err = openapi3filter.ValidateResponse(context.WithoutCancel(req.Context()), &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 rsp.StatusCode,
+		Header:                 rsp.Header,
+		Body:                   io.NopCloser(bytes.NewReader(rspBody)),
+		Options:                input.Options,
+	})
+	if err != nil {
+		mismatch := &Mismatch{
+			Operation: route.Operation.OperationID,
+			Method:    req.Method,
+			Path:      route.Path,
+			Response:  true,
+			Status:    rsp.StatusCode,
+			Err:       err,
+		}
+		if err := v.report(mismatch); err != nil {
+			return nil, err
+		}
+	}
+	return rsp, nil
+}
+
+// Do validates and sends the request, so a Validator can also be used as a generated client's HttpRequestDoer.
+func (v *Validator) Do(req *http.Request) (*http.Response, error) {
+	return v.RoundTrip(req)
+}
+
+// route finds the operation for the request. The server URL may include a path prefix, so we match on the suffix.
+func (v *Validator) route(req *http.Request) *routers.Route {
+	if route, ok := v.routes[req.Method+" "+req.URL.Path]; ok {
+		return route
+	}
+	for _, route := range v.routes {
+		if route.Method == req.Method && strings.HasSuffix(req.URL.Path, route.Path) {
+			return route
+		}
+	}
+	return nil
+}
+
+func (v *Validator) report(m *Mismatch) error {
+	if v.Mode == Fail {
+		return m
+	}
+	if v.OnMismatch != nil {
+		v.OnMismatch(m)
+	} else {
+		log.Print(m)
+	}
+	return nil
+}
+
+func read(body io.ReadCloser) ([]byte, error) {
+	if body == nil || body == http.NoBody {
+		return nil, nil
+	}
+	defer body.Close()
+	return io.ReadAll(body)
+}
@@ -0,0 +1,113 @@
+package validator_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/denvrtest"
+	"github.com/denvrdata/go-denvr/response"
+	"github.com/denvrdata/go-denvr/validator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMode(t *testing.T) {
+	for s, want := range map[string]validator.Mode{"": validator.Off, "log": validator.Log, " FAIL ": validator.Fail} {
+		mode, err := validator.ParseMode(s)
+		require.NoError(t, err)
+		assert.Equal(t, want, mode)
+	}
+	_, err := validator.ParseMode("strict")
+	assert.EqualError(t, err, `invalid validation mode "strict", expected log or fail`)
+}
+
+func TestValidator(t *testing.T) {
+	server := `{"id": "vm1", "cluster": "Msc1", "status": "ONLINE", "gpus": 8}`
+	created := 0
+	ts := denvrtest.NewServer(t)
+	ts.HandleFunc(
+		"/api/v1/servers/virtual/GetServer",
+		func(resp http.ResponseWriter, req *http.Request) {
+			resp.Header().Set("Content-Type", "application/json; charset=utf-8")
+			resp.Write([]byte(server))
+		},
+	)
+	ts.HandleFunc(
+		"/api/v1/servers/virtual/CreateServer",
+		func(resp http.ResponseWriter, req *http.Request) {
+			created++
+			resp.Header().Set("Content-Type", "application/json")
+			resp.Write([]byte(`{"id": "vm1"}`))
+		},
+	)
+	ts.Reply("/api/v1/servers/virtual/DestroyServer", http.StatusNotFound, `{"error": {"message": "not found", "gpus": "eight"}}`)
+
+	wrapped, err := validator.Wrap(ts.Client(), validator.Fail)
+	require.NoError(t, err)
+	client := virtual.Client{Server: ts.URL, Client: wrapped}
+	params := &virtual.GetServerParams{Id: "vm1", Namespace: "denvr", Cluster: "Msc1"}
+
+	vm, err := client.GetServer(context.Background(), params)
+	require.NoError(t, err)
+	assert.Equal(t, "ONLINE", *vm.Status)
+
+	// Type changes and unknown fields are both drift
+	for _, body := range []string{
+		`{"id": "vm1", "gpus": "8"}`,
+		`{"id": "vm1", "gpuCount": 8}`,
+	} {
+		server = body
+		_, err = client.GetServer(context.Background(), params)
+		var mismatch *validator.Mismatch
+		require.ErrorAs(t, err, &mismatch)
+		assert.True(t, mismatch.Response)
+		assert.Equal(t, "GetServer", mismatch.Operation)
+		assert.Equal(t, http.MethodGet, mismatch.Method)
+		assert.Equal(t, "/api/v1/servers/virtual/GetServer", mismatch.Path)
+		assert.Equal(t, http.StatusOK, mismatch.Status)
+	}
+
+	// Invalid requests aren't sent
+	_, err = client.CreateServer(context.Background(), virtual.CreateServerJSONRequestBody{Configuration: "H100_80GB_SXM_8x"})
+	var mismatch *validator.Mismatch
+	require.ErrorAs(t, err, &mismatch)
+	assert.False(t, mismatch.Response)
+	assert.ErrorContains(t, err, "CreateServer request to POST /api/v1/servers/virtual/CreateServer doesn't match the spec")
+	assert.Equal(t, 0, created)
+
+	_, err = client.CreateServer(
+		context.Background(),
+		virtual.CreateServerJSONRequestBody{Cluster: "Msc1", Configuration: "H100_80GB_SXM_8x", Vpc: "denvr", SshKeys: []string{}},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, 1, created)
+
+	// Error responses aren't part of the spec
+	_, err = client.DestroyServer(context.Background(), &virtual.DestroyServerParams{Id: "vm1", Namespace: "denvr", Cluster: "Msc1"})
+	assert.True(t, response.IsStatus(err, http.StatusNotFound))
+
+	// In Log mode mismatches are reported but the response is still returned
+	var reported []*validator.Mismatch
+	v, err := validator.New(ts.Client().Transport, validator.Log)
+	require.NoError(t, err)
+	v.OnMismatch = func(m *validator.Mismatch) { reported = append(reported, m) }
+	client.Client = v
+
+	server = `{"id": "vm1", "status": "ONLINE", "gpuCount": 8}`
+	vm, err = client.GetServer(context.Background(), params)
+	require.NoError(t, err)
+	assert.Equal(t, "ONLINE", *vm.Status)
+	require.Len(t, reported, 1)
+	assert.True(t, errors.Is(reported[0], reported[0].Err))
+	assert.Contains(t, reported[0].Error(), "GetServer response (200) from GET /api/v1/servers/virtual/GetServer doesn't match the spec")
+}
+
+func TestWrapOff(t *testing.T) {
+	client := &http.Client{}
+	wrapped, err := validator.Wrap(client, validator.Off)
+	require.NoError(t, err)
+	assert.Same(t, client, wrapped)
+}
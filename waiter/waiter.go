@@ -0,0 +1,171 @@
+// Package waiter polls servers and applications until they reach a desired status.
+package waiter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/response"
+)
+
+// Gone is a pseudo status which matches once the resource no longer exists (e.g., after a destroy).
+const Gone = "GONE"
+
+// FailedStatuses are the statuses which will stop a wait early with a FailedError.
+// The spec only documents the healthy server statuses, so TestFailedStatuses checks these against it.
+var FailedStatuses = []string{"FAILED", "ERROR"}
+
+// logLimit is the number of log lines captured in a FailedError.
+const logLimit = 2000
+
+// Options controls how often we poll and how long we wait.
+// The zero value polls every 10 seconds until the context is cancelled.
+type Options struct {
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+// FailedError is returned when a resource reaches one of the FailedStatuses.
+type FailedError struct {
+	Kind   string
+	Name   string
+	Status string
+	Reason string
+	// Logs is a snapshot of the boot or runtime logs taken when the failure was seen (empty if unavailable)
+	Logs string
+}
+
+func (e *FailedError) Error() string {
+	msg := fmt.Sprintf("%s %s reached status %s", e.Kind, e.Name, e.Status)
+	if e.Reason != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Reason)
+	}
+	return msg
+}
+
+// Poll calls fn every interval until it reports done, returns an error or the context is cancelled.
+func Poll(ctx context.Context, opts Options, fn func(ctx context.Context) (bool, error)) error {
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		done, err := fn(ctx)
+		if err != nil || done {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Server waits for a virtual server to reach one of the given statuses (e.g., "ONLINE", "OFFLINE" or Gone).
+func Server(
+	ctx context.Context,
+	client virtual.ClientInterface,
+	params virtual.GetServerParams,
+	opts Options,
+	statuses ...string,
+) (*virtual.VirtualServerDetailsItem, error) {
+	var server *virtual.VirtualServerDetailsItem
+	err := Poll(
+		ctx,
+		opts,
+		func(ctx context.Context) (bool, error) {
+			var err error
+			server, err = client.GetServer(ctx, &params)
+			if response.IsStatus(err, http.StatusNotFound) && slices.Contains(statuses, Gone) {
+				return true, nil
+			} else if err != nil {
+				return false, err
+			}
+
+			status := server.GetStatus()
+			if slices.Contains(statuses, status) {
+				return true, nil
+			}
+			if slices.Contains(FailedStatuses, status) {
+				// The logs are best effort, we'd rather return the failure than an error fetching them.
+				logs, _ := client.GetVirtualMachineBootLogs(
+					ctx,
+					&virtual.GetVirtualMachineBootLogsParams{
+						Id:        params.Id,
+						Namespace: params.Namespace,
+						Cluster:   params.Cluster,
+						Limit:     logLimit,
+					},
+				)
+				ferr := &FailedError{Kind: "server", Name: params.Id, Status: status}
+				if logs != nil {
+					ferr.Logs = logs.GetBootLogs()
+				}
+				return false, ferr
+			}
+			return false, nil
+		},
+	)
+	return server, err
+}
+
+// Application waits for an application to reach one of the given statuses (e.g., "ONLINE", "OFFLINE" or Gone).
+func Application(
+	ctx context.Context,
+	client applications.ClientInterface,
+	params applications.GetApplicationDetailsParams,
+	opts Options,
+	statuses ...string,
+) (*applications.ApplicationsApiDetails, error) {
+	var app *applications.ApplicationsApiDetails
+	err := Poll(
+		ctx,
+		opts,
+		func(ctx context.Context) (bool, error) {
+			var err error
+			app, err = client.GetApplicationDetails(ctx, &params)
+			if response.IsStatus(err, http.StatusNotFound) && slices.Contains(statuses, Gone) {
+				return true, nil
+			} else if err != nil {
+				return false, err
+			}
+
+			var status, reason string
+			if details := app.InstanceDetails; details != nil {
+				status = details.GetStatus()
+				reason = strings.TrimSpace(fmt.Sprintf("%s %s", details.GetStatusReason(), details.GetStatusMessage()))
+			}
+			if slices.Contains(statuses, status) {
+				return true, nil
+			}
+			if slices.Contains(FailedStatuses, status) {
+				logs, _ := client.GetApplicationRuntimeLogs(
+					ctx,
+					&applications.GetApplicationRuntimeLogsParams{Id: params.Id, Cluster: params.Cluster, Limit: logLimit},
+				)
+				ferr := &FailedError{Kind: "application", Name: params.Id, Status: status, Reason: reason}
+				if logs != nil {
+					ferr.Logs = logs.GetLogs()
+				}
+				return false, ferr
+			}
+			return false, nil
+		},
+	)
+	return app, err
+}
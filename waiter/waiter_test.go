@@ -0,0 +1,123 @@
+package waiter_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1 "github.com/denvrdata/go-denvr/api/v1"
+	"github.com/denvrdata/go-denvr/api/v1/servers/applications"
+	"github.com/denvrdata/go-denvr/api/v1/servers/virtual"
+	"github.com/denvrdata/go-denvr/denvrtest"
+	"github.com/denvrdata/go-denvr/waiter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaiter(t *testing.T) {
+	var polls atomic.Int32
+	server := denvrtest.NewServer(t)
+	server.HandleFunc(
+		"/api/v1/servers/virtual/GetServer",
+		func(resp http.ResponseWriter, req *http.Request) {
+			status := "PENDING"
+			if polls.Add(1) >= 3 {
+				status = "ONLINE"
+			}
+			json.NewEncoder(resp).Encode(map[string]string{"id": req.URL.Query().Get("Id"), "status": status})
+		},
+	)
+	server.HandleFunc(
+		"/api/v1/servers/applications/GetApplicationDetails",
+		func(resp http.ResponseWriter, req *http.Request) {
+			if req.URL.Query().Get("Id") == "missing" {
+				resp.WriteHeader(http.StatusNotFound)
+				resp.Write([]byte(`{"success": false, "error": {"code": 404, "message": "not found"}}`))
+				return
+			}
+			resp.Write([]byte(`{"instanceDetails": {"status": "FAILED", "statusReason": "ImagePullBackOff"}}`))
+		},
+	)
+	server.Reply("/api/v1/servers/applications/GetApplicationRuntimeLogs", http.StatusOK, `{"logs": "pulling image\nimage not found"}`)
+
+	vc := server.Virtual()
+	ac := server.Applications()
+	opts := waiter.Options{Interval: time.Millisecond, Timeout: time.Second}
+
+	t.Run(
+		"Server",
+		func(t *testing.T) {
+			vm, err := waiter.Server(context.TODO(), vc, virtual.GetServerParams{Id: "vm"}, opts, "ONLINE")
+			assert.NoError(t, err)
+			assert.Equal(t, "ONLINE", *vm.Status)
+			assert.Equal(t, int32(3), polls.Load())
+		},
+	)
+
+	t.Run(
+		"Failed",
+		func(t *testing.T) {
+			_, err := waiter.Application(context.TODO(), ac, applications.GetApplicationDetailsParams{Id: "app"}, opts, "ONLINE")
+			var ferr *waiter.FailedError
+			assert.ErrorAs(t, err, &ferr)
+			assert.Equal(t, "application app reached status FAILED: ImagePullBackOff", err.Error())
+			assert.Equal(t, "pulling image\nimage not found", ferr.Logs)
+		},
+	)
+
+	t.Run(
+		"Gone",
+		func(t *testing.T) {
+			_, err := waiter.Application(context.TODO(), ac, applications.GetApplicationDetailsParams{Id: "missing"}, opts, waiter.Gone)
+			assert.NoError(t, err)
+
+			_, err = waiter.Application(context.TODO(), ac, applications.GetApplicationDetailsParams{Id: "missing"}, opts, "ONLINE")
+			assert.ErrorContains(t, err, "404")
+		},
+	)
+
+	t.Run(
+		"Timeout",
+		func(t *testing.T) {
+			err := waiter.Poll(
+				context.TODO(),
+				waiter.Options{Interval: time.Millisecond, Timeout: 10 * time.Millisecond},
+				func(ctx context.Context) (bool, error) { return false, nil },
+			)
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+		},
+	)
+}
+
+func TestFailedStatuses(t *testing.T) {
+	doc, err := v1.Load()
+	require.NoError(t, err)
+	schema := doc.Components.Schemas["VirtualServerDetailsItem"].Value.Properties["status"].Value
+
+	// Prefer the enum if the spec ever gains one, otherwise use the examples in the description
+	var statuses []string
+	for _, v := range schema.Enum {
+		statuses = append(statuses, v.(string))
+	}
+	if len(statuses) == 0 {
+		for _, m := range regexp.MustCompile(`'([A-Z_]+)'`).FindAllStringSubmatch(schema.Description, -1) {
+			statuses = append(statuses, m[1])
+		}
+	}
+	require.NotEmpty(t, statuses)
+
+	// The statuses we wait for must be real. An enum must list every failure, while the description
+	// only covers the healthy lifecycle, so none of those may count as a failure.
+	assert.Subset(t, statuses, []string{"ONLINE", "OFFLINE"})
+	for _, status := range waiter.FailedStatuses {
+		if len(schema.Enum) > 0 {
+			assert.Contains(t, statuses, status)
+		} else {
+			assert.NotContains(t, statuses, status)
+		}
+	}
+}